@@ -0,0 +1,172 @@
+package golangsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type CaseReason string
+
+const (
+	CaseReturnRequestLabel      CaseReason = "return.request_label"
+	CaseNondeliveryNotDelivered CaseReason = "nondelivery.not_delivered"
+	CaseNondeliveryDamaged      CaseReason = "nondelivery.damaged"
+	CaseNondeliveryEmptyBox     CaseReason = "nondelivery.empty_box"
+	CaseTrackingRequestUpdate   CaseReason = "tracking.request_update"
+	CaseCancelForced            CaseReason = "cancel.forced_cancellation"
+	CaseOther                   CaseReason = "other"
+)
+
+type CaseRequest struct {
+	Reason  CaseReason `json:"reason"`
+	Message string     `json:"message"`
+}
+
+type CaseResponse struct {
+	RequestId    string             `json:"request_id"`
+	Type         string             `json:"_type"`
+	Code         string             `json:"code"`
+	Data         *ErrorDataResponse `json:"data,omitempty"`
+	ErrorMessage string             `json:"message"`
+	CaseId       string             `json:"case_id"`
+	State        string             `json:"state"`
+	Messages     []CaseMessage      `json:"messages"`
+}
+
+type CaseMessage struct {
+	Type      string    `json:"type"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BalanceResponse struct {
+	Type         string             `json:"_type"`
+	Code         string             `json:"code"`
+	Data         *ErrorDataResponse `json:"data,omitempty"`
+	ErrorMessage string             `json:"message"`
+	Balance      int                `json:"balance"`
+	Currency     string             `json:"currency"`
+}
+
+type AddressesResponse struct {
+	Type         string             `json:"_type"`
+	Code         string             `json:"code"`
+	Data         *ErrorDataResponse `json:"data,omitempty"`
+	ErrorMessage string             `json:"message"`
+	Addresses    []Address          `json:"addresses"`
+}
+
+type AddFundsRequest struct {
+	Amount        int            `json:"amount"`
+	Currency      string         `json:"currency,omitempty"`
+	PaymentMethod *PaymentMethod `json:"payment_method,omitempty"`
+}
+
+func (z Zinc) CreateCase(requestId string, req CaseRequest) (*CaseResponse, error) {
+	return z.CreateCaseContext(context.Background(), requestId, req)
+}
+
+func (z Zinc) CreateCaseContext(ctx context.Context, requestId string, req CaseRequest) (*CaseResponse, error) {
+	requestPath, err := z.endpoint(ctx, nil, "orders", requestId, "case")
+	if err != nil {
+		return nil, err
+	}
+	body := new(bytes.Buffer)
+	if err := json.NewEncoder(body).Encode(req); err != nil {
+		return nil, WrapError(err)
+	}
+	var resp CaseResponse
+	if decoded, err := z.sendRequest(ctx, "POST", requestPath, body, z.orderTimeout(), &resp); err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
+	}
+	return &resp, nil
+}
+
+func (z Zinc) GetCase(requestId string) (*CaseResponse, error) {
+	return z.GetCaseContext(context.Background(), requestId)
+}
+
+func (z Zinc) GetCaseContext(ctx context.Context, requestId string) (*CaseResponse, error) {
+	requestPath, err := z.endpoint(ctx, nil, "orders", requestId, "case")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp CaseResponse
+	if decoded, err := z.sendRequest(ctx, "GET", requestPath, nil, z.orderTimeout(), &resp); err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
+	}
+	return &resp, nil
+}
+
+func (z Zinc) GetBalance() (*BalanceResponse, error) {
+	return z.GetBalanceContext(context.Background())
+}
+
+func (z Zinc) GetBalanceContext(ctx context.Context) (*BalanceResponse, error) {
+	requestPath, err := z.endpoint(ctx, nil, "zma", "balance")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp BalanceResponse
+	if decoded, err := z.sendRequest(ctx, "GET", requestPath, nil, z.orderTimeout(), &resp); err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
+	}
+	return &resp, nil
+}
+
+func (z Zinc) AddFunds(req AddFundsRequest) (*BalanceResponse, error) {
+	return z.AddFundsContext(context.Background(), req)
+}
+
+func (z Zinc) AddFundsContext(ctx context.Context, req AddFundsRequest) (*BalanceResponse, error) {
+	if req.Amount <= 0 {
+		return nil, SimpleError(fmt.Sprintf("Invalid funding amount %d", req.Amount))
+	}
+	requestPath, err := z.endpoint(ctx, nil, "zma", "funds")
+	if err != nil {
+		return nil, err
+	}
+	body := new(bytes.Buffer)
+	if err := json.NewEncoder(body).Encode(req); err != nil {
+		return nil, WrapError(err)
+	}
+	var resp BalanceResponse
+	if decoded, err := z.sendRequest(ctx, "POST", requestPath, body, z.orderTimeout(), &resp); err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
+	}
+	return &resp, nil
+}
+
+func (z Zinc) ListAddresses() ([]Address, error) {
+	return z.ListAddressesContext(context.Background())
+}
+
+func (z Zinc) ListAddressesContext(ctx context.Context) ([]Address, error) {
+	requestPath, err := z.endpoint(ctx, nil, "zma", "addresses")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp AddressesResponse
+	if err := z.SendRequestContext(ctx, "GET", requestPath, nil, z.orderTimeout(), &resp); err != nil {
+		return nil, asZincError(err)
+	}
+	return resp.Addresses, nil
+}
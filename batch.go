@@ -0,0 +1,51 @@
+package golangsdk
+
+import (
+	"context"
+	"sync"
+)
+
+type ProductInfoResult struct {
+	Offers  *ProductOffersResponse
+	Details *ProductDetailsResponse
+	Err     error
+}
+
+func (z Zinc) GetProductInfoBatch(ctx context.Context, ids []string, retailer Retailer, opts ProductOptions, concurrency int) (map[string]ProductInfoResult, error) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	jobs := make(chan string)
+	results := make(map[string]ProductInfoResult, len(ids))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				offers, details, err := z.GetProductInfoContext(ctx, id, retailer, opts)
+				mu.Lock()
+				results[id] = ProductInfoResult{Offers: offers, Details: details, Err: err}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	seen := make(map[string]bool, len(ids))
+feed:
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		select {
+		case <-ctx.Done():
+			break feed
+		case jobs <- id:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	return results, ctx.Err()
+}
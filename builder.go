@@ -0,0 +1,161 @@
+package golangsdk
+
+import "fmt"
+
+type OrderBuilder struct {
+	order OrderRequest
+}
+
+func NewOrderBuilder(retailer Retailer) *OrderBuilder {
+	return &OrderBuilder{order: OrderRequest{Retailer: retailer}}
+}
+
+func (b *OrderBuilder) AddProduct(productId string, quantity int) *OrderBuilder {
+	b.order.Products = append(b.order.Products, Product{ProductId: productId, Quantity: quantity})
+	return b
+}
+
+func (b *OrderBuilder) AddProductWithCriteria(productId string, quantity int, criteria SellerSelectionCriteria) *OrderBuilder {
+	b.order.Products = append(b.order.Products, Product{
+		ProductId:               productId,
+		Quantity:                quantity,
+		SellerSelectionCriteria: &criteria,
+	})
+	return b
+}
+
+func (b *OrderBuilder) ShipTo(address Address) *OrderBuilder {
+	b.order.ShippingAddress = &address
+	return b
+}
+
+func (b *OrderBuilder) BillTo(address Address) *OrderBuilder {
+	b.order.BillingAddress = &address
+	return b
+}
+
+func (b *OrderBuilder) PayWith(paymentMethod PaymentMethod) *OrderBuilder {
+	b.order.PaymentMethod = &paymentMethod
+	return b
+}
+
+func (b *OrderBuilder) WithCredentials(credentials RetailerCredentials) *OrderBuilder {
+	b.order.RetailerCredentials = &credentials
+	return b
+}
+
+func (b *OrderBuilder) WithShippingMethod(method ShippingMethod) *OrderBuilder {
+	b.order.ShippingMethod = method
+	return b
+}
+
+func (b *OrderBuilder) WithShipping(shipping Shipping) *OrderBuilder {
+	b.order.Shipping = &shipping
+	return b
+}
+
+func (b *OrderBuilder) WithMaxPrice(maxPrice int) *OrderBuilder {
+	b.order.MaxPrice = maxPrice
+	return b
+}
+
+func (b *OrderBuilder) WithWebhooks(webhooks Webhooks) *OrderBuilder {
+	b.order.Webhooks = &webhooks
+	return b
+}
+
+func (b *OrderBuilder) AsGift(message string) *OrderBuilder {
+	b.order.IsGift = true
+	b.order.GiftMessage = message
+	return b
+}
+
+func (b *OrderBuilder) WithGiftOptions(options GiftOptions) *OrderBuilder {
+	b.order.IsGift = true
+	b.order.GiftOptions = &options
+	return b
+}
+
+func (b *OrderBuilder) WithPromoCode(code string) *OrderBuilder {
+	b.order.PromoCodes = append(b.order.PromoCodes, code)
+	return b
+}
+
+func (b *OrderBuilder) WithAddax(options AddaxOptions) *OrderBuilder {
+	b.order.Addax = true
+	b.order.AddaxOptions = &options
+	return b
+}
+
+func (b *OrderBuilder) WithClientNote(key string, value interface{}) *OrderBuilder {
+	if b.order.ClientNotes == nil {
+		b.order.ClientNotes = make(map[string]interface{})
+	}
+	b.order.ClientNotes[key] = value
+	return b
+}
+
+func (b *OrderBuilder) WithIdempotencyKey(key string) *OrderBuilder {
+	b.order.IdempotencyKey = key
+	return b
+}
+
+func (b *OrderBuilder) Build() (OrderRequest, error) {
+	order := b.order
+	order.Products = append([]Product(nil), b.order.Products...)
+	order.PromoCodes = append([]string(nil), b.order.PromoCodes...)
+	if b.order.ClientNotes != nil {
+		order.ClientNotes = make(map[string]interface{}, len(b.order.ClientNotes))
+		for k, v := range b.order.ClientNotes {
+			order.ClientNotes[k] = v
+		}
+	}
+	if err := order.Validate(); err != nil {
+		return order, err
+	}
+	return order, nil
+}
+
+// SplitByShipping splits an order into one order per distinct shipping
+// preference. Shipping is order-level in the API, so products that need
+// different urgency must be placed as separate orders. Products without an
+// entry in shipping keep the order's own Shipping.
+func SplitByShipping(order OrderRequest, shipping map[string]Shipping) []OrderRequest {
+	var (
+		orders []OrderRequest
+		groups = make(map[Shipping]int)
+		base   = -1
+	)
+	for _, p := range order.Products {
+		pref, ok := shipping[p.ProductId]
+		i := base
+		if ok {
+			if existing, seen := groups[pref]; seen {
+				i = existing
+			} else {
+				i = -1
+			}
+		}
+		if i < 0 {
+			split := order
+			split.Products = nil
+			if ok {
+				split.Shipping = &pref
+			}
+			orders = append(orders, split)
+			i = len(orders) - 1
+			if ok {
+				groups[pref] = i
+			} else {
+				base = i
+			}
+		}
+		orders[i].Products = append(orders[i].Products, p)
+	}
+	if len(orders) > 1 && order.IdempotencyKey != "" {
+		for i := range orders {
+			orders[i].IdempotencyKey = fmt.Sprintf("%v-%d", order.IdempotencyKey, i+1)
+		}
+	}
+	return orders
+}
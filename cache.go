@@ -0,0 +1,78 @@
+package golangsdk
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+const defaultCacheTTL = time.Minute * 10
+
+type memoryCacheEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]memoryCacheEntry)}
+}
+
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := memoryCacheEntry{value: value}
+	if ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+	c.entries[key] = entry
+}
+
+func (z Zinc) cacheGet(key string, options ProductOptions, resp interface{}) bool {
+	if z.Cache == nil || options.SkipCache || !options.NewerThan.IsZero() {
+		return false
+	}
+	value, ok := z.Cache.Get(key)
+	if !ok {
+		return false
+	}
+	return json.Unmarshal(value, resp) == nil
+}
+
+func (z Zinc) cacheSet(key string, resp interface{}) {
+	if z.Cache == nil {
+		return
+	}
+	value, err := json.Marshal(resp)
+	if err != nil {
+		return
+	}
+	ttl := z.CacheTTL
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+	z.Cache.Set(key, value, ttl)
+}
@@ -0,0 +1,37 @@
+package golangsdk_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestEmptyResponseIsNotCached(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	z, _ := golangsdk.NewZinc("user", "")
+	z.ZincBaseURL = srv.URL + "/v1"
+	z.Cache = golangsdk.NewMemoryCache()
+	z.CacheTTL = time.Minute
+
+	for i := 0; i < 2; i++ {
+		if _, err := z.GetProductOffers("B07XJ8C8F5", golangsdk.Amazon, golangsdk.ProductOptions{}); err != nil {
+			t.Fatalf("GetProductOffers: %v", err)
+		}
+		if _, err := z.GetProductDetails("B07XJ8C8F5", golangsdk.Amazon, golangsdk.ProductOptions{}); err != nil {
+			t.Fatalf("GetProductDetails: %v", err)
+		}
+	}
+	if got := hits.Load(); got != 4 {
+		t.Errorf("server hits = %d, want 4", got)
+	}
+}
@@ -0,0 +1,63 @@
+package golangsdk
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+type Client interface {
+	SendOrder(order OrderRequest) (*OrderResponse, error)
+	SendOrderContext(ctx context.Context, order OrderRequest) (*OrderResponse, error)
+	GetOrderStatus(requestId string) (*OrderResponse, error)
+	GetOrderStatusContext(ctx context.Context, requestId string) (*OrderResponse, error)
+	ListOrders(opts ListOrdersOptions) (*OrderListResponse, error)
+	ListOrdersContext(ctx context.Context, opts ListOrdersOptions) (*OrderListResponse, error)
+	AbortOrder(requestId string) (*OrderResponse, error)
+	AbortOrderContext(ctx context.Context, requestId string) (*OrderResponse, error)
+	Reship(requestId string, newAddress Address) (*OrderResponse, error)
+	ReshipContext(ctx context.Context, requestId string, newAddress Address) (*OrderResponse, error)
+	PollOrder(ctx context.Context, requestId string, interval time.Duration) (*OrderResponse, error)
+	WaitForTracking(ctx context.Context, requestId string, interval time.Duration) ([]Tracking, error)
+
+	GetProductOffers(productId string, retailer Retailer, options ProductOptions) (*ProductOffersResponse, error)
+	GetProductOffersContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ProductOffersResponse, error)
+	GetAllProductOffers(productId string, retailer Retailer, options ProductOptions) (*ProductOffersResponse, error)
+	GetAllProductOffersContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ProductOffersResponse, error)
+	GetProductDetails(productId string, retailer Retailer, options ProductOptions) (*ProductDetailsResponse, error)
+	GetProductDetailsContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ProductDetailsResponse, error)
+	GetProductInfo(productId string, retailer Retailer, options ProductOptions) (*ProductOffersResponse, *ProductDetailsResponse, error)
+	GetProductInfoContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ProductOffersResponse, *ProductDetailsResponse, error)
+	GetProduct(productId string, retailer Retailer, options ProductOptions) (*ProductInfo, error)
+	GetProductContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ProductInfo, error)
+	GetProductPartial(productId string, retailer Retailer, options ProductOptions) (*ProductInfo, error)
+	GetProductPartialContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ProductInfo, error)
+	GetProductInfoBatch(ctx context.Context, ids []string, retailer Retailer, opts ProductOptions, concurrency int) (map[string]ProductInfoResult, error)
+	SearchProducts(query string, retailer Retailer, options ProductOptions) (*ProductSearchResponse, error)
+	SearchProductsContext(ctx context.Context, query string, retailer Retailer, options ProductOptions) (*ProductSearchResponse, error)
+	GetReviews(productId string, retailer Retailer, options ProductOptions) (*ReviewsResponse, error)
+	GetReviewsContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ReviewsResponse, error)
+
+	CreateReturn(req ReturnRequest) (*ReturnResponse, error)
+	CreateReturnContext(ctx context.Context, req ReturnRequest) (*ReturnResponse, error)
+	GetReturnStatus(requestId string) (*ReturnResponse, error)
+	GetReturnStatusContext(ctx context.Context, requestId string) (*ReturnResponse, error)
+
+	CreateCase(requestId string, req CaseRequest) (*CaseResponse, error)
+	CreateCaseContext(ctx context.Context, requestId string, req CaseRequest) (*CaseResponse, error)
+	GetCase(requestId string) (*CaseResponse, error)
+	GetCaseContext(ctx context.Context, requestId string) (*CaseResponse, error)
+	GetBalance() (*BalanceResponse, error)
+	GetBalanceContext(ctx context.Context) (*BalanceResponse, error)
+	AddFunds(req AddFundsRequest) (*BalanceResponse, error)
+	AddFundsContext(ctx context.Context, req AddFundsRequest) (*BalanceResponse, error)
+	ListAddresses() ([]Address, error)
+	ListAddressesContext(ctx context.Context) ([]Address, error)
+
+	Do(ctx context.Context, method, path string, body io.Reader) (*Response, error)
+
+	Close() error
+}
+
+var _ Client = Zinc{}
+var _ Client = (*Zinc)(nil)
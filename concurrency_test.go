@@ -0,0 +1,65 @@
+package golangsdk_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/zincio/golangsdk"
+	"github.com/zincio/golangsdk/zinctest"
+)
+
+func TestClientConcurrentUse(t *testing.T) {
+	s := zinctest.NewServer()
+	defer s.Close()
+	productId := "B07XJ8C8F5"
+	s.SetProductOffers(productId, zinctest.SampleProductOffers())
+	s.SetProductDetails(productId, zinctest.SampleProductDetails())
+
+	z := s.Client()
+	z.Cache = golangsdk.NewMemoryCache()
+	z.RateLimiter = golangsdk.NewRateLimiter(1e6, 100)
+
+	var metadata golangsdk.ResponseMetadata
+	ctx := golangsdk.WithResponseMetadata(context.Background(), &metadata)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, _, err := z.GetProductInfoContext(ctx, productId, golangsdk.Amazon, golangsdk.ProductOptions{}); err != nil {
+					t.Error(err)
+					return
+				}
+				if _, err := z.GetProductPartialContext(ctx, productId, golangsdk.Amazon, golangsdk.ProductOptions{}); err != nil {
+					t.Error(err)
+					return
+				}
+				if _, err := z.SendOrderContext(ctx, zinctest.SampleOrderRequest()); err != nil {
+					t.Error(err)
+					return
+				}
+				metadata.RequestId()
+				metadata.Status()
+				metadata.Headers()
+				metadata.All()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if _, err := z.GetProductInfoBatch(ctx, []string{productId, productId}, golangsdk.Amazon, golangsdk.ProductOptions{}, 4); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(s.ReceivedOrders()), 32*10; got != want {
+		t.Errorf("received %d orders, want %d", got, want)
+	}
+	if got := metadata.Status(); got != 200 {
+		t.Errorf("metadata status = %d, want 200", got)
+	}
+	if got, want := len(metadata.All()), 32*10; got < want {
+		t.Errorf("metadata recorded %d responses, want at least %d", got, want)
+	}
+}
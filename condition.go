@@ -0,0 +1,82 @@
+package golangsdk
+
+import "strings"
+
+type Condition string
+
+const (
+	ConditionUnknown        Condition = ""
+	ConditionNew            Condition = "New"
+	ConditionUsed           Condition = "Used"
+	ConditionUsedLikeNew    Condition = "Used - Like New"
+	ConditionUsedVeryGood   Condition = "Used - Very Good"
+	ConditionUsedGood       Condition = "Used - Good"
+	ConditionUsedAcceptable Condition = "Used - Acceptable"
+	ConditionRefurbished    Condition = "Refurbished"
+	ConditionCollectible    Condition = "Collectible"
+)
+
+var usedConditionGrades = map[string]Condition{
+	"like new":   ConditionUsedLikeNew,
+	"mint":       ConditionUsedLikeNew,
+	"very good":  ConditionUsedVeryGood,
+	"good":       ConditionUsedGood,
+	"acceptable": ConditionUsedAcceptable,
+}
+
+func ParseCondition(raw string) Condition {
+	normalized := strings.Join(strings.FieldsFunc(strings.ToLower(raw), func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_' || r == ':' || r == '–' || r == ','
+	}), " ")
+	switch {
+	case normalized == "":
+		return ConditionUnknown
+	case normalized == "new" || normalized == "brand new":
+		return ConditionNew
+	case strings.HasPrefix(normalized, "collectible"):
+		return ConditionCollectible
+	case strings.Contains(normalized, "refurbished") || strings.Contains(normalized, "renewed"):
+		return ConditionRefurbished
+	case normalized == "used":
+		return ConditionUsed
+	case strings.HasPrefix(normalized, "used "):
+		if grade, ok := usedConditionGrades[strings.TrimPrefix(normalized, "used ")]; ok {
+			return grade
+		}
+		return ConditionUsed
+	default:
+		return ConditionUnknown
+	}
+}
+
+func (c Condition) String() string {
+	if c == ConditionUnknown {
+		return "Unknown"
+	}
+	return string(c)
+}
+
+func (c Condition) IsUsed() bool {
+	switch c {
+	case ConditionUsed, ConditionUsedLikeNew, ConditionUsedVeryGood, ConditionUsedGood, ConditionUsedAcceptable:
+		return true
+	default:
+		return false
+	}
+}
+
+func (o ProductOffer) ParsedCondition() Condition {
+	return ParseCondition(o.Condition)
+}
+
+func WithCondition(conditions ...Condition) OfferFilter {
+	return func(o ProductOffer) bool {
+		parsed := o.ParsedCondition()
+		for _, c := range conditions {
+			if parsed == c {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,25 @@
+package golangsdk
+
+import "context"
+
+type credentialsContextKey struct{}
+
+type zincCredentials struct {
+	user     string
+	password string
+}
+
+func WithZincCredentials(ctx context.Context, zincUser, zincPassword string) context.Context {
+	return context.WithValue(ctx, credentialsContextKey{}, zincCredentials{user: zincUser, password: zincPassword})
+}
+
+func WithClientToken(ctx context.Context, clientToken string) context.Context {
+	return WithZincCredentials(ctx, clientToken, "")
+}
+
+func (z Zinc) credentials(ctx context.Context) (string, string) {
+	if c, ok := ctx.Value(credentialsContextKey{}).(zincCredentials); ok {
+		return c.user, c.password
+	}
+	return z.ZincUser, z.ZincPassword
+}
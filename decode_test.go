@@ -0,0 +1,48 @@
+package golangsdk_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestSendRequestIgnoresTrailingHTTPNoise(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "trailing status line and headers",
+			body: "{\"request_id\":\"abc\",\"_type\":\"order_response\"}\r\nHTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n",
+		},
+		{
+			name: "embedded status line in a string value",
+			body: `{"request_id":"abc","_type":"order_response","message":"upstream said HTTP/1.1 200 OK"}`,
+		},
+		{
+			name: "embedded status line and trailing noise",
+			body: "{\"request_id\":\"abc\",\"_type\":\"order_response\",\"message\":\"HTTP/1.1 200 OK\"}\nHTTP/1.1 200 OK\n{\"request_id\":\"other\"}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+
+			z, _ := golangsdk.NewZinc("user", "")
+			var resp golangsdk.OrderResponse
+			if err := z.SendRequest("GET", srv.URL, nil, 0, &resp); err != nil {
+				t.Fatalf("SendRequest: %v", err)
+			}
+			if resp.RequestId != "abc" {
+				t.Errorf("request_id = %q, want %q", resp.RequestId, "abc")
+			}
+		})
+	}
+}
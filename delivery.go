@@ -0,0 +1,30 @@
+package golangsdk
+
+import "time"
+
+type DeliveryEstimate struct {
+	Earliest time.Time
+	Latest   time.Time
+}
+
+func (o ProductOffer) EstimateDelivery(orderDate time.Time, minTransitDays, maxTransitDays int) DeliveryEstimate {
+	minDays := o.HandlingDays.Min + minTransitDays
+	maxDays := o.HandlingDays.Max + maxTransitDays
+	if maxDays < minDays {
+		maxDays = minDays
+	}
+	return DeliveryEstimate{
+		Earliest: addBusinessDays(orderDate, minDays),
+		Latest:   addBusinessDays(orderDate, maxDays),
+	}
+}
+
+func addBusinessDays(t time.Time, days int) time.Time {
+	for days > 0 {
+		t = t.AddDate(0, 0, 1)
+		if wd := t.Weekday(); wd != time.Saturday && wd != time.Sunday {
+			days--
+		}
+	}
+	return t
+}
@@ -0,0 +1,58 @@
+package golangsdk_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestEmptyResponseBody(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusNoContent} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(status)
+			}))
+			defer srv.Close()
+
+			z, _ := golangsdk.NewZinc("user", "")
+			z.ZincBaseURL = srv.URL + "/v1"
+			z.SkipValidation = true
+
+			var resp golangsdk.OrderResponse
+			err := z.SendRequest("GET", srv.URL+"/v1/orders/abc", nil, 0, &resp)
+			if status == http.StatusNoContent {
+				if err != nil {
+					t.Fatalf("SendRequest: %v", err)
+				}
+			} else {
+				var zerr golangsdk.ZincError
+				if !errors.As(err, &zerr) || zerr.StatusCode != status {
+					t.Fatalf("SendRequest error = %#v, want a ZincError with StatusCode %d", err, status)
+				}
+			}
+
+			order, err := z.SendOrder(golangsdk.OrderRequest{
+				Retailer: golangsdk.Amazon,
+				Products: []golangsdk.Product{{ProductId: "B07XJ8C8F5", Quantity: 1}},
+			})
+			if err == nil {
+				t.Fatalf("SendOrder = %+v, want an error for an empty body", order)
+			}
+			if order != nil {
+				t.Errorf("SendOrder returned a response with no request_id: %+v", order)
+			}
+
+			abort, err := z.AbortOrder("abc")
+			if err != nil {
+				t.Fatalf("AbortOrder: %v", err)
+			}
+			if got := abort.AbortResult(); got != golangsdk.AbortPending {
+				t.Errorf("AbortResult = %v, want %v", got, golangsdk.AbortPending)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package golangsdk
+
+import "strings"
+
+func (p *ProductDetailsResponse) EPID(idType string) (string, bool) {
+	for _, epid := range p.Epids {
+		if strings.EqualFold(epid.Type, idType) && epid.Value != "" {
+			return epid.Value, true
+		}
+	}
+	return "", false
+}
+
+func (p *ProductDetailsResponse) UPC() (string, bool) {
+	return p.EPID("UPC")
+}
+
+func (p *ProductDetailsResponse) EAN() (string, bool) {
+	return p.EPID("EAN")
+}
+
+func (p *ProductDetailsResponse) ASIN() (string, bool) {
+	return p.EPID("ASIN")
+}
+
+func (p *ProductDetailsResponse) GTIN() (string, bool) {
+	for _, idType := range []string{"GTIN", "EAN", "UPC"} {
+		if value, ok := p.EPID(idType); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,105 @@
+package golangsdk
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+)
+
+const (
+	CodeRequestProcessing           = "request_processing"
+	CodeAbortedRequest              = "aborted_request"
+	CodeInternalError               = "internal_error"
+	CodeInvalidRequest              = "invalid_request"
+	CodeInvalidClientToken          = "invalid_client_token"
+	CodeUnauthorizedAccess          = "unauthorized_access"
+	CodeTooManyRequests             = "too_many_requests"
+	CodeInvalidProductId            = "invalid_product_id"
+	CodeProductUnavailable          = "product_unavailable"
+	CodeInvalidQuantity             = "invalid_quantity"
+	CodeMaxQuantityExceeded         = "max_quantity_exceeded"
+	CodeMaxPriceExceeded            = "max_price_exceeded"
+	CodeInvalidShippingMethod       = "invalid_shipping_method"
+	CodeShippingAddressRefused      = "shipping_address_refused"
+	CodeShippingAddressUnavailable  = "shipping_address_unavailable"
+	CodeInvalidBillingAddress       = "invalid_billing_address"
+	CodeInvalidPaymentMethod        = "invalid_payment_method"
+	CodeInvalidCardNumber           = "invalid_card_number"
+	CodeInvalidSecurityCode         = "invalid_security_code"
+	CodeCardDeclined                = "card_declined"
+	CodePaymentInfoProblem          = "payment_info_problem"
+	CodeInsufficientZMABalance      = "insufficient_zma_balance"
+	CodeInvalidGiftOptions          = "invalid_gift_options"
+	CodeInvalidPromoCode            = "invalid_promo_code"
+	CodeInvalidLoginCredentials     = "invalid_login_credentials"
+	CodeAccountLoginFailed          = "account_login_failed"
+	CodeAccountLocked               = "account_locked"
+	CodeAdditionalInfoRequired      = "additional_information_required"
+	CodeDuplicateOrder              = "duplicate_order"
+	CodeBrandNotAccepted            = "brand_not_accepted"
+	CodeRetailerUnavailable         = "retailer_unavailable"
+	CodeAlreadyPlaced               = "already_placed"
+	CodeVerificationCodeRequired    = "verification_code_required"
+	CodeSellerSelectionCriteriaFail = "no_offers_match_seller_selection_criteria"
+)
+
+func (z ZincError) HasCode(code string) bool {
+	return z.Code == code
+}
+
+func (z ZincError) Needs2FA() bool {
+	switch z.Code {
+	case CodeVerificationCodeRequired:
+		return true
+	case CodeAdditionalInfoRequired:
+		message := strings.ToLower(z.Data.Message + " " + z.ErrorMessage)
+		return strings.Contains(message, "verification") || strings.Contains(message, "2fa") || strings.Contains(message, "two-factor")
+	default:
+		return false
+	}
+}
+
+func (z ZincError) IsAccountLocked() bool {
+	return z.Code == CodeAccountLocked
+}
+
+var retryableCodes = map[string]bool{
+	CodeRequestProcessing:   true,
+	CodeInternalError:       true,
+	CodeTooManyRequests:     true,
+	CodeRetailerUnavailable: true,
+}
+
+func (z ZincError) IsRetryable() bool {
+	if retryableCodes[z.Code] {
+		return true
+	}
+	if z.Code != "" {
+		return false
+	}
+	if z.StatusCode != 0 {
+		return z.StatusCode == 429 || z.StatusCode >= 500
+	}
+	var netErr net.Error
+	if errors.As(z.Err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(z.Err, context.DeadlineExceeded)
+}
+
+func (z ZincError) ValidatorErrors() []ValidatorError {
+	return z.Data.ValidatorErrors
+}
+
+func (z ZincError) FieldErrors() map[string]string {
+	fields := make(map[string]string, len(z.Data.ValidatorErrors))
+	for _, v := range z.Data.ValidatorErrors {
+		if existing, ok := fields[v.Path]; ok {
+			fields[v.Path] = existing + "; " + v.Message
+			continue
+		}
+		fields[v.Path] = v.Message
+	}
+	return fields
+}
@@ -2,21 +2,37 @@ package golangsdk
 
 import (
 	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"crypto/rand"
 	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
+	"log/slog"
+	"mime"
+	"net"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 const (
-	zincBaseURL = "https://api.zinc.io/v1"
+	Version = "1.0.0"
+
+	zincBaseURL         = "https://api.zinc.io/v1"
+	defaultOrderTimeout = time.Second * 30
+
+	defaultMaxResponseBytes = 64 << 20
+	maxOfferPages           = 50
 )
 
 type Retailer string
@@ -26,6 +42,11 @@ const (
 	AmazonUK   Retailer = "amazon_uk"
 	AmazonCA   Retailer = "amazon_ca"
 	AmazonMX   Retailer = "amazon_mx"
+	AmazonDE   Retailer = "amazon_de"
+	AmazonFR   Retailer = "amazon_fr"
+	AmazonIT   Retailer = "amazon_it"
+	AmazonES   Retailer = "amazon_es"
+	AmazonJP   Retailer = "amazon_jp"
 	Walmart    Retailer = "walmart"
 	Aliexpress Retailer = "aliexpress"
 )
@@ -34,10 +55,70 @@ var DefaultProductOptions = ProductOptions{
 	Timeout: time.Duration(time.Second * 90),
 }
 
+var defaultHTTPClient = &http.Client{
+	Transport: newTransport(nil),
+}
+
+var insecureHTTPClient = &http.Client{
+	Transport: newTransport(&tls.Config{InsecureSkipVerify: true}),
+}
+
+func newTransport(tlsConfig *tls.Config) *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   32,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig:       tlsConfig,
+	}
+}
+
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Printf(format string, v ...interface{}) {}
+
+// Zinc is safe for concurrent use by multiple goroutines. Set its fields
+// before first use and treat them as read-only afterwards; custom Limiter,
+// Cache, Tracer and hook implementations must also be safe for concurrent use.
 type Zinc struct {
-	ZincUser string
+	ZincUser     string
 	ZincPassword string
-	ZincBaseURL string
+	ZincBaseURL  string
+	HTTPClient   *http.Client
+
+	InsecureSkipVerify    bool
+	Logger                Logger
+	SkipValidation        bool
+	WebhookSecret         string
+	RawResponseHook       func(requestPath string, statusCode int, body []byte)
+	DefaultTimeout        time.Duration
+	TestMode              bool
+	Tracer                Tracer
+	RequestHooks          []func(*http.Request) error
+	ResponseHooks         []func(*http.Response)
+	CheckPriceBeforeOrder bool
+	RateLimiter           Limiter
+	Cache                 Cache
+	CacheTTL              time.Duration
+	AppName               string
+	Slog                  *slog.Logger
+	MaxResponseBytes      int64
+	MaxRateLimitRetries   int
+	MaxRetryAfter         time.Duration
+	RetailerMaxAge        map[Retailer]int
+	APIVersion            string
+	OffersVersion         string
 }
 
 func GetRetailer(retailer string) (Retailer, error) {
@@ -50,18 +131,57 @@ func GetRetailer(retailer string) (Retailer, error) {
 		return AmazonCA, nil
 	case "amazon_mx":
 		return AmazonMX, nil
+	case "amazon_de":
+		return AmazonDE, nil
+	case "amazon_fr":
+		return AmazonFR, nil
+	case "amazon_it":
+		return AmazonIT, nil
+	case "amazon_es":
+		return AmazonES, nil
+	case "amazon_jp":
+		return AmazonJP, nil
 	case "walmart":
 		return Walmart, nil
 	case "aliexpress":
 		return Aliexpress, nil
 	default:
-		return Amazon, fmt.Errorf("Invalid retailer string")
+		return "", fmt.Errorf("Invalid retailer string %q", retailer)
+	}
+}
+
+var retailerNames = map[Retailer]string{
+	Amazon:     "Amazon",
+	AmazonUK:   "Amazon UK",
+	AmazonCA:   "Amazon Canada",
+	AmazonMX:   "Amazon Mexico",
+	AmazonDE:   "Amazon Germany",
+	AmazonFR:   "Amazon France",
+	AmazonIT:   "Amazon Italy",
+	AmazonES:   "Amazon Spain",
+	AmazonJP:   "Amazon Japan",
+	Walmart:    "Walmart",
+	Aliexpress: "AliExpress",
+}
+
+func (r Retailer) String() string {
+	if name, ok := retailerNames[r]; ok {
+		return name
+	}
+	return string(r)
+}
+
+func MustGetRetailer(retailer string) Retailer {
+	r, err := GetRetailer(retailer)
+	if err != nil {
+		panic(err)
 	}
+	return r
 }
 
 func NewZinc(zincUser string, zincPassword string) (*Zinc, error) {
 	z := Zinc{
-		ZincUser:		  zincUser,
+		ZincUser:     zincUser,
 		ZincPassword: zincPassword,
 		ZincBaseURL:  zincBaseURL,
 	}
@@ -69,20 +189,88 @@ func NewZinc(zincUser string, zincPassword string) (*Zinc, error) {
 }
 
 type OrderRequest struct {
-	Retailer            Retailer             `json:"retailer"`
-	Products            []Product            `json:"products"`
-	ShippingMethod      string               `json:"shipping_method,omitempty"`
-	Shipping            *Shipping            `json:"shipping,omitempty"`
-	ShippingAddress     *Address             `json:"shipping_address"`
-	BillingAddress      *Address             `json:"billing_address,omitempty"`
-	PaymentMethod       *PaymentMethod       `json:"payment_method,omitempty"`
-	RetailerCredentials *RetailerCredentials `json:"retailer_credentials,omitempty"`
-	GiftMessage         string               `json:"gift_message,omitempty"`
-	IsGift              bool                 `json:"is_gift"`
-	MaxPrice            int                  `json:"max_price"`
-	Webhooks            *Webhooks            `json:"webhooks,omitempty"`
-	Bundled             bool                 `json:"bundled"`
-	Addax               bool                 `json:"addax"`
+	Retailer            Retailer               `json:"retailer"`
+	Products            []Product              `json:"products"`
+	ShippingMethod      ShippingMethod         `json:"shipping_method,omitempty"`
+	Shipping            *Shipping              `json:"shipping,omitempty"`
+	ShippingAddress     *Address               `json:"shipping_address"`
+	BillingAddress      *Address               `json:"billing_address,omitempty"`
+	PaymentMethod       *PaymentMethod         `json:"payment_method,omitempty"`
+	RetailerCredentials *RetailerCredentials   `json:"retailer_credentials,omitempty"`
+	GiftMessage         string                 `json:"gift_message,omitempty"`
+	IsGift              bool                   `json:"is_gift,omitempty"`
+	GiftOptions         *GiftOptions           `json:"gift_options,omitempty"`
+	MaxPrice            int                    `json:"max_price,omitempty"`
+	PromoCodes          []string               `json:"promo_codes,omitempty"`
+	ClientNotes         map[string]interface{} `json:"client_notes,omitempty"`
+	Webhooks            *Webhooks              `json:"webhooks,omitempty"`
+	Bundled             bool                   `json:"bundled,omitempty"`
+	Addax               bool                   `json:"addax,omitempty"`
+	AddaxOptions        *AddaxOptions          `json:"addax_options,omitempty"`
+	Test                bool                   `json:"test,omitempty"`
+	// IdempotencyKey deduplicates order placement server-side. Set it once
+	// per logical order (see EnsureIdempotencyKey) and reuse the same
+	// OrderRequest when retrying SendOrder so a retry never buys twice.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+}
+
+func NewIdempotencyKey() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(b)
+}
+
+func (o *OrderRequest) EnsureIdempotencyKey() string {
+	if o.IdempotencyKey == "" {
+		o.IdempotencyKey = NewIdempotencyKey()
+	}
+	return o.IdempotencyKey
+}
+
+type ShippingMethod string
+
+const (
+	ShippingCheapest ShippingMethod = "cheapest"
+	ShippingFastest  ShippingMethod = "fastest"
+	ShippingFree     ShippingMethod = "free"
+)
+
+func (m ShippingMethod) Valid() bool {
+	switch m {
+	case ShippingCheapest, ShippingFastest, ShippingFree:
+		return true
+	}
+	return false
+}
+
+type OrderBy string
+
+const (
+	OrderByPrice OrderBy = "price"
+	OrderBySpeed OrderBy = "speed"
+)
+
+func (o OrderBy) Valid() bool {
+	switch o {
+	case OrderByPrice, OrderBySpeed:
+		return true
+	}
+	return false
+}
+
+type GiftOptions struct {
+	GiftWrap     bool   `json:"gift_wrap,omitempty"`
+	ConcealCosts bool   `json:"conceal_costs,omitempty"`
+	SenderName   string `json:"sender_name,omitempty"`
+}
+
+type AddaxOptions struct {
+	QueueTimeout int    `json:"queue_timeout,omitempty"`
+	ProxyCountry string `json:"proxy_country,omitempty"`
+	ProxyZipCode string `json:"proxy_zip_code,omitempty"`
+	SessionId    string `json:"session_id,omitempty"`
 }
 
 type Product struct {
@@ -93,9 +281,9 @@ type Product struct {
 }
 
 type Shipping struct {
-	OrderBy  string `json:"order_by,omitempty"`
-	MaxDays  int    `json:"max_days"`
-	MaxPrice int    `json:"max_price"`
+	OrderBy  OrderBy `json:"order_by,omitempty"`
+	MaxDays  int     `json:"max_days"`
+	MaxPrice int     `json:"max_price"`
 }
 
 type Address struct {
@@ -120,10 +308,13 @@ type PaymentMethod struct {
 }
 
 type RetailerCredentials struct {
-	Email            string `json:"email"`
+	Email            string `json:"email,omitempty"`
+	Username         string `json:"username,omitempty"`
+	PhoneNumber      string `json:"phone_number,omitempty"`
 	Password         string `json:"password"`
 	VerificationCode string `json:"verification_code,omitempty"`
 	Totp2FAKey       string `json:"totp_2fa_key"`
+	SessionCookies   string `json:"session_cookies,omitempty"`
 }
 
 type Webhooks struct {
@@ -177,6 +368,8 @@ type ProductOffersResponse struct {
 	Data     ErrorDataResponse `json:"data"`
 	Status   string            `json:"status"`
 	Retailer string            `json:"retailer"`
+	Page     int               `json:"page"`
+	HasMore  bool              `json:"has_more"`
 	Offers   []ProductOffer    `json:"offers"`
 }
 
@@ -232,6 +425,50 @@ type ProductDetailsResponse struct {
 	FeatureBullets     []string            `json:"feature_bullets"`
 }
 
+type ProductSearchResponse struct {
+	Code     string                `json:"code"`
+	Data     ErrorDataResponse     `json:"data"`
+	Status   string                `json:"status"`
+	Retailer string                `json:"retailer"`
+	Query    string                `json:"query"`
+	Page     int                   `json:"page"`
+	Results  []ProductSearchResult `json:"results"`
+}
+
+type ProductSearchResult struct {
+	ProductId  string  `json:"product_id"`
+	Title      string  `json:"title"`
+	Image      string  `json:"image"`
+	Price      int     `json:"price"`
+	Stars      float64 `json:"stars"`
+	NumReviews int     `json:"num_reviews"`
+	Brand      string  `json:"brand"`
+	Prime      bool    `json:"prime"`
+}
+
+type ReviewsResponse struct {
+	Code       string            `json:"code"`
+	Data       ErrorDataResponse `json:"data"`
+	Status     string            `json:"status"`
+	Retailer   string            `json:"retailer"`
+	ProductId  string            `json:"product_id"`
+	Stars      float64           `json:"stars"`
+	NumReviews int               `json:"num_reviews"`
+	Page       int               `json:"page"`
+	Reviews    []Review          `json:"reviews"`
+}
+
+type Review struct {
+	Id               string `json:"id"`
+	Title            string `json:"title"`
+	Body             string `json:"body"`
+	Rating           int    `json:"rating"`
+	Author           string `json:"author"`
+	Date             string `json:"date"`
+	VerifiedPurchase bool   `json:"verified_purchase"`
+	HelpfulVotes     int    `json:"helpful_votes"`
+}
+
 type ExternalProductId struct {
 	Type  string `json:"type"`
 	Value string `json:"value"`
@@ -264,91 +501,463 @@ type ProductOptions struct {
 	Priority  int           `json:"priority"`
 	NewerThan time.Time     `json:"newer_than"`
 	Timeout   time.Duration `json:"timeout"`
+	Page      int           `json:"page"`
+
+	OfferFilters []OfferFilter `json:"-"`
+	// SkipCache asks for live data: the local Cache is bypassed and the
+	// RetailerMaxAge default is not sent, though an explicit MaxAge is.
+	SkipCache bool `json:"-"`
 }
 
 type ZincError struct {
 	ErrorMessage string            `json:"error"`
 	Code         string            `json:"code"`
 	Data         ErrorDataResponse `json:"data"`
+	StatusCode   int               `json:"-"`
+	Body         string            `json:"-"`
+	Err          error             `json:"-"`
 }
 
 func (z ZincError) Error() string {
 	return z.ErrorMessage
 }
 
+func (z ZincError) Unwrap() error {
+	return z.Err
+}
+
 func SimpleError(errorStr string) ZincError {
 	return ZincError{ErrorMessage: errorStr}
 }
 
+func WrapError(err error) ZincError {
+	return ZincError{ErrorMessage: err.Error(), Err: err}
+}
+
+func asZincError(err error) ZincError {
+	if zerr, ok := err.(ZincError); ok {
+		return zerr
+	}
+	return WrapError(err)
+}
+
+func httpStatusError(statusCode int, body []byte) ZincError {
+	var apiErr struct {
+		Code    string            `json:"code"`
+		Message string            `json:"message"`
+		Data    ErrorDataResponse `json:"data"`
+	}
+	json.Unmarshal(body, &apiErr)
+	redactedBody := redactBody(body)
+	msg := fmt.Sprintf("Zinc API returned HTTP status %d body=%v", statusCode, redactedBody)
+	return ZincError{
+		ErrorMessage: msg,
+		Code:         apiErr.Code,
+		Data:         apiErr.Data,
+		StatusCode:   statusCode,
+		Body:         redactedBody,
+	}
+}
+
 func (z Zinc) GetProductInfo(productId string, retailer Retailer, options ProductOptions) (*ProductOffersResponse, *ProductDetailsResponse, error) {
-	offersChan := make(chan *ProductOffersResponse, 1)
-	detailsChan := make(chan *ProductDetailsResponse, 1)
-	errorsChan := make(chan error, 2)
+	return z.GetProductInfoContext(context.Background(), productId, retailer, options)
+}
+
+func (z Zinc) GetProductInfoContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ProductOffersResponse, *ProductDetailsResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
+	var (
+		wg         sync.WaitGroup
+		failOnce   sync.Once
+		firstErr   error
+		offers     *ProductOffersResponse
+		details    *ProductDetailsResponse
+		offersErr  error
+		detailsErr error
+	)
+	fail := func(err error) {
+		failOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+	wg.Add(2)
 	go func() {
-		offers, err := z.GetProductOffers(productId, retailer, options)
-		errorsChan <- err
-		offersChan <- offers
+		defer wg.Done()
+		if offers, offersErr = z.GetProductOffersContext(ctx, productId, retailer, options); offersErr != nil {
+			fail(offersErr)
+		}
 	}()
-
 	go func() {
-		details, err := z.GetProductDetails(productId, retailer, options)
-		errorsChan <- err
-		detailsChan <- details
+		defer wg.Done()
+		if details, detailsErr = z.GetProductDetailsContext(ctx, productId, retailer, options); detailsErr != nil {
+			fail(detailsErr)
+		}
 	}()
+	wg.Wait()
 
-	offers := <-offersChan
-	details := <-detailsChan
-	for i := 0; i < 2; i++ {
-		err := <-errorsChan
-		if err != nil {
-			return nil, nil, err
-		}
+	switch {
+	case firstErr == nil:
+		return offers, details, nil
+	case offersErr != nil && detailsErr != nil && !errors.Is(offersErr, context.Canceled) && !errors.Is(detailsErr, context.Canceled):
+		return nil, nil, productInfoError(offersErr, detailsErr)
+	default:
+		return nil, nil, firstErr
 	}
-	return offers, details, nil
+}
+
+func productInfoError(offersErr, detailsErr error) ZincError {
+	msg := fmt.Sprintf("Unable to get product info offers_error=%v details_error=%v", offersErr, detailsErr)
+	return ZincError{ErrorMessage: msg, Err: errors.Join(offersErr, detailsErr)}
 }
 
 func (z Zinc) SendOrder(order OrderRequest) (*OrderResponse, error) {
-	requestPath := fmt.Sprintf("%v/orders", z.ZincBaseURL)
+	return z.SendOrderContext(context.Background(), order)
+}
+
+func (z Zinc) SendOrderContext(ctx context.Context, order OrderRequest) (*OrderResponse, error) {
+	requestPath, body, err := z.buildOrderRequest(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	if z.CheckPriceBeforeOrder && order.MaxPrice > 0 {
+		if _, err := z.CheckOrderPrice(ctx, order); err != nil {
+			return nil, err
+		}
+	}
+	var resp OrderResponse
+	ctx = WithRetailer(ctx, order.Retailer)
+	if decoded, err := z.sendRequest(ctx, "POST", requestPath, bytes.NewReader(body), z.orderTimeout(), &resp); err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
+	}
+	if resp.RequestId == "" {
+		return nil, SimpleError("Zinc API accepted the order without returning a request_id")
+	}
+	return &resp, nil
+}
+
+func (z Zinc) BuildOrderRequest(order OrderRequest) (requestPath string, body []byte, err error) {
+	return z.buildOrderRequest(context.Background(), order)
+}
+
+func (z Zinc) buildOrderRequest(ctx context.Context, order OrderRequest) (requestPath string, body []byte, err error) {
+	if z.TestMode {
+		order.Test = true
+	}
+	products := make([]Product, len(order.Products))
+	for i, p := range order.Products {
+		p.ProductId = canonicalProductId(order.Retailer, p.ProductId)
+		products[i] = p
+	}
+	order.Products = products
+	if !z.SkipValidation {
+		if err := order.Validate(); err != nil {
+			return "", nil, err
+		}
+	}
+	requestPath, err = z.endpoint(ctx, nil, "orders")
+	if err != nil {
+		return "", nil, err
+	}
+	if body, err = json.Marshal(order); err != nil {
+		return "", nil, WrapError(err)
+	}
+	return requestPath, body, nil
+}
+
+func (z Zinc) GetOrderStatus(requestId string) (*OrderResponse, error) {
+	return z.GetOrderStatusContext(context.Background(), requestId)
+}
+
+func (z Zinc) GetOrderStatusContext(ctx context.Context, requestId string) (*OrderResponse, error) {
+	requestPath, err := z.endpoint(ctx, nil, "orders", requestId)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp OrderResponse
+	if decoded, err := z.sendRequest(ctx, "GET", requestPath, nil, z.orderTimeout(), &resp); err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
+	}
+	return &resp, nil
+}
+
+type ListOrdersOptions struct {
+	Limit         int
+	StartingAfter string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Status        string
+}
+
+type OrderListResponse struct {
+	Type         string             `json:"_type"`
+	Code         string             `json:"code"`
+	Data         *ErrorDataResponse `json:"data,omitempty"`
+	ErrorMessage string             `json:"message"`
+	Orders       []OrderResponse    `json:"orders"`
+	HasMore      bool               `json:"has_more"`
+	NextCursor   string             `json:"next_starting_after"`
+}
+
+func (z Zinc) ListOrders(opts ListOrdersOptions) (*OrderListResponse, error) {
+	return z.ListOrdersContext(context.Background(), opts)
+}
+
+func (z Zinc) ListOrdersContext(ctx context.Context, opts ListOrdersOptions) (*OrderListResponse, error) {
+	values := url.Values{}
+	if opts.Limit != 0 {
+		values.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if opts.StartingAfter != "" {
+		values.Set("starting_after", opts.StartingAfter)
+	}
+	if !opts.CreatedAfter.IsZero() {
+		values.Set("created_after", strconv.FormatInt(opts.CreatedAfter.Unix(), 10))
+	}
+	if !opts.CreatedBefore.IsZero() {
+		values.Set("created_before", strconv.FormatInt(opts.CreatedBefore.Unix(), 10))
+	}
+	if opts.Status != "" {
+		values.Set("status", opts.Status)
+	}
+	requestPath, err := z.endpoint(ctx, values, "orders")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp OrderListResponse
+	if decoded, err := z.sendRequest(ctx, "GET", requestPath, nil, z.orderTimeout(), &resp); err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
+	}
+	if resp.HasMore && resp.NextCursor == "" && len(resp.Orders) > 0 {
+		resp.NextCursor = resp.Orders[len(resp.Orders)-1].RequestId
+	}
+	return &resp, nil
+}
+
+func (z Zinc) AbortOrder(requestId string) (*OrderResponse, error) {
+	return z.AbortOrderContext(context.Background(), requestId)
+}
+
+func (z Zinc) AbortOrderContext(ctx context.Context, requestId string) (*OrderResponse, error) {
+	requestPath, err := z.endpoint(ctx, nil, "orders", requestId, "abort")
+	if err != nil {
+		return nil, err
+	}
+
+	// A successful abort comes back as an aborted_request error response,
+	// and an abort still in flight may come back empty, so both are left
+	// to AbortResult.
+	var resp OrderResponse
+	if decoded, err := z.sendRequest(ctx, "POST", requestPath, nil, z.orderTimeout(), &resp); err != nil && !decoded && !errors.Is(err, errEmptyBody) {
+		zerr := asZincError(err)
+		zerr.ErrorMessage = fmt.Sprintf("Unable to abort request_id=%v: %v", requestId, zerr.ErrorMessage)
+		return nil, zerr
+	}
+	switch resp.AbortResult() {
+	case AbortAccepted, AbortPending:
+		return &resp, nil
+	case AbortTooLate:
+		msg := fmt.Sprintf("Too late to abort request_id=%v", requestId)
+		return &resp, ZincError{Code: resp.Code, ErrorMessage: msg}
+	default:
+		zerr := resp.zincError()
+		zerr.ErrorMessage = fmt.Sprintf("Abort of request_id=%v rejected: %v", requestId, zerr.ErrorMessage)
+		return &resp, zerr
+	}
+}
+
+func (z Zinc) Reship(requestId string, newAddress Address) (*OrderResponse, error) {
+	return z.ReshipContext(context.Background(), requestId, newAddress)
+}
+
+func (z Zinc) ReshipContext(ctx context.Context, requestId string, newAddress Address) (*OrderResponse, error) {
+	if !z.SkipValidation {
+		if err := newAddress.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	requestPath, err := z.endpoint(ctx, nil, "orders", requestId, "reship")
+	if err != nil {
+		return nil, err
+	}
 	body := new(bytes.Buffer)
-	if err := json.NewEncoder(body).Encode(order); err != nil {
-		return nil, SimpleError(err.Error())
+	reship := struct {
+		ShippingAddress Address `json:"shipping_address"`
+	}{newAddress}
+	if err := json.NewEncoder(body).Encode(reship); err != nil {
+		return nil, WrapError(err)
 	}
 	var resp OrderResponse
-	if err := z.SendRequest("POST", requestPath, body, time.Duration(time.Second*30), &resp); err != nil {
-		return nil, SimpleError(err.Error())
+	if decoded, err := z.sendRequest(ctx, "POST", requestPath, body, z.orderTimeout(), &resp); err != nil {
+		if decoded {
+			return &resp, err
+		}
+		zerr := asZincError(err)
+		zerr.ErrorMessage = fmt.Sprintf("Unable to reship request_id=%v: %v", requestId, zerr.ErrorMessage)
+		return nil, zerr
 	}
 	return &resp, nil
 }
 
+type AbortResult string
+
+const (
+	AbortAccepted AbortResult = "accepted"
+	AbortPending  AbortResult = "pending"
+	AbortTooLate  AbortResult = "too_late"
+	AbortRejected AbortResult = "rejected"
+)
+
+func (o *OrderResponse) AbortResult() AbortResult {
+	switch {
+	case o.Type == "error" && o.Code == CodeAbortedRequest:
+		return AbortAccepted
+	case o.IsProcessing(), o.Type == "" && o.Code == "" && o.RequestId == "":
+		return AbortPending
+	case o.Type != "error" || o.Code == CodeAlreadyPlaced:
+		return AbortTooLate
+	default:
+		return AbortRejected
+	}
+}
+
+// IsProcessing reports whether Zinc is still working on the request. Zinc
+// signals this either with an error response whose code is
+// request_processing, or, right after SendOrder, with a bare
+// {"request_id": ...} acknowledgement that carries no _type yet.
+func (o *OrderResponse) IsProcessing() bool {
+	if o.Type == "" {
+		return o.RequestId != ""
+	}
+	return o.Type == "error" && o.Code == CodeRequestProcessing
+}
+
+func (o *OrderResponse) ClientNotes() map[string]interface{} {
+	if o.Request == nil {
+		return nil
+	}
+	return o.Request.ClientNotes
+}
+
+func (o *OrderResponse) IsDone() bool {
+	return !o.IsProcessing()
+}
+
+func (o *OrderResponse) zincError() ZincError {
+	return apiError(o.Code, o.ErrorMessage, o.Data)
+}
+
+func apiError(code, message string, data *ErrorDataResponse) ZincError {
+	msg := fmt.Sprintf("Zinc API returned error code=%v message=%v", code, message)
+	zerr := ZincError{Code: code, ErrorMessage: msg}
+	if data != nil {
+		zerr.Data = *data
+		if len(data.ValidatorErrors) > 0 {
+			fieldErrors := make([]string, 0, len(data.ValidatorErrors))
+			for _, v := range data.ValidatorErrors {
+				fieldErrors = append(fieldErrors, fmt.Sprintf("%v: %v", v.Path, v.Message))
+			}
+			zerr.ErrorMessage += " validator_errors=" + strings.Join(fieldErrors, "; ")
+		}
+	}
+	return zerr
+}
+
 func (z Zinc) GetProductOffers(productId string, retailer Retailer, options ProductOptions) (*ProductOffersResponse, error) {
+	return z.GetProductOffersContext(context.Background(), productId, retailer, options)
+}
+
+func (z Zinc) GetProductOffersContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ProductOffersResponse, error) {
 	values := url.Values{}
 	values.Set("retailer", string(retailer))
-	values.Set("version", "2")
-	if options.MaxAge != 0 {
-		values.Set("max_age", strconv.Itoa(options.MaxAge))
+	values.Set("version", z.offersVersion())
+	if options.Page != 0 {
+		values.Set("page", strconv.Itoa(options.Page))
+	}
+	if maxAge := z.maxAge(retailer, options); maxAge != 0 {
+		values.Set("max_age", strconv.Itoa(maxAge))
 	}
 	if !options.NewerThan.IsZero() {
 		values.Set("newer_than", strconv.FormatInt(options.NewerThan.Unix(), 10))
 	}
-	requestPath := fmt.Sprintf("%v/products/%v/offers?%v", z.ZincBaseURL, productId, values.Encode())
+	if options.Priority != 0 {
+		values.Set("priority", strconv.Itoa(options.Priority))
+	}
+	requestPath, err := z.endpoint(ctx, values, "products", productId, "offers")
+	if err != nil {
+		return nil, err
+	}
 
 	var resp ProductOffersResponse
-	if err := z.SendRequest("GET", requestPath, nil, options.Timeout, &resp); err != nil {
-		return nil, SimpleError(err.Error())
+	if z.cacheGet(requestPath, options, &resp) {
+		resp.applyFilters(options.OfferFilters)
+		return &resp, nil
+	}
+	decoded, err := z.sendRequest(ctx, "GET", requestPath, nil, z.productTimeout(options.Timeout), &resp)
+	if err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
 	}
-	if resp.Status == "failed" {
-		msg := fmt.Sprintf("Zinc API returned status 'failed' data=%+v", resp.Data)
-		return &resp, ZincError{Code: resp.Code, ErrorMessage: msg, Data: resp.Data}
+	if decoded {
+		z.cacheSet(requestPath, &resp)
 	}
+	resp.applyFilters(options.OfferFilters)
 	return &resp, nil
 }
 
+func (z Zinc) GetAllProductOffers(productId string, retailer Retailer, options ProductOptions) (*ProductOffersResponse, error) {
+	return z.GetAllProductOffersContext(context.Background(), productId, retailer, options)
+}
+
+func (z Zinc) GetAllProductOffersContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ProductOffersResponse, error) {
+	filters := options.OfferFilters
+	options.OfferFilters = nil
+	if options.Page == 0 {
+		options.Page = 1
+	}
+	all, err := z.GetProductOffersContext(ctx, productId, retailer, options)
+	if err != nil {
+		return all, err
+	}
+	merged := *all
+	merged.Offers = append([]ProductOffer(nil), all.Offers...)
+	for page := all; page.HasMore && options.Page < maxOfferPages; {
+		options.Page++
+		if page, err = z.GetProductOffersContext(ctx, productId, retailer, options); err != nil {
+			merged.applyFilters(filters)
+			return &merged, err
+		}
+		merged.Offers = append(merged.Offers, page.Offers...)
+		merged.HasMore = page.HasMore
+	}
+	merged.Page = 0
+	merged.applyFilters(filters)
+	return &merged, nil
+}
+
 func (z Zinc) GetProductDetails(productId string, retailer Retailer, options ProductOptions) (*ProductDetailsResponse, error) {
+	return z.GetProductDetailsContext(context.Background(), productId, retailer, options)
+}
+
+func (z Zinc) GetProductDetailsContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ProductDetailsResponse, error) {
 	values := url.Values{}
 	values.Set("retailer", string(retailer))
-	if options.MaxAge != 0 {
-		values.Set("max_age", strconv.Itoa(options.MaxAge))
+	if maxAge := z.maxAge(retailer, options); maxAge != 0 {
+		values.Set("max_age", strconv.Itoa(maxAge))
 	}
 	if !options.NewerThan.IsZero() {
 		values.Set("newer_than", strconv.FormatInt(options.NewerThan.Unix(), 10))
@@ -356,51 +965,429 @@ func (z Zinc) GetProductDetails(productId string, retailer Retailer, options Pro
 	if options.Priority != 0 {
 		values.Set("priority", strconv.Itoa(options.Priority))
 	}
-	requestPath := fmt.Sprintf("%v/products/%v?%v", z.ZincBaseURL, productId, values.Encode())
+	requestPath, err := z.endpoint(ctx, values, "products", productId)
+	if err != nil {
+		return nil, err
+	}
 
 	var resp ProductDetailsResponse
-	if err := z.SendRequest("GET", requestPath, nil, options.Timeout, &resp); err != nil {
-		return nil, SimpleError(err.Error())
+	if z.cacheGet(requestPath, options, &resp) {
+		return &resp, nil
 	}
-	if resp.Status == "failed" {
-		msg := fmt.Sprintf("Zinc API returned status 'failed' data=%+v", resp.Data)
-		return &resp, ZincError{Code: resp.Code, ErrorMessage: msg, Data: resp.Data}
+	decoded, err := z.sendRequest(ctx, "GET", requestPath, nil, z.productTimeout(options.Timeout), &resp)
+	if err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
+	}
+	if decoded {
+		z.cacheSet(requestPath, &resp)
 	}
 	return &resp, nil
 }
 
-func cleanRespBody(respBody []byte) []byte {
-	str := string(respBody)
-	i := strings.Index(str, "HTTP/1.1 200 OK")
-	if i == -1 {
-		return respBody
+func (z Zinc) SearchProducts(query string, retailer Retailer, options ProductOptions) (*ProductSearchResponse, error) {
+	return z.SearchProductsContext(context.Background(), query, retailer, options)
+}
+
+func (z Zinc) SearchProductsContext(ctx context.Context, query string, retailer Retailer, options ProductOptions) (*ProductSearchResponse, error) {
+	values := url.Values{}
+	values.Set("query", query)
+	values.Set("retailer", string(retailer))
+	if options.Page != 0 {
+		values.Set("page", strconv.Itoa(options.Page))
+	}
+	if maxAge := z.maxAge(retailer, options); maxAge != 0 {
+		values.Set("max_age", strconv.Itoa(maxAge))
+	}
+	if options.Priority != 0 {
+		values.Set("priority", strconv.Itoa(options.Priority))
+	}
+	requestPath, err := z.endpoint(ctx, values, "search")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ProductSearchResponse
+	if decoded, err := z.sendRequest(ctx, "GET", requestPath, nil, z.productTimeout(options.Timeout), &resp); err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
 	}
-	return []byte(str[:i])
+	return &resp, nil
 }
 
-func (z Zinc) SendRequest(method, requestPath string, body io.Reader, timeout time.Duration, resp interface{}) error {
-	httpReq, err := http.NewRequest(method, requestPath, body)
+func (z Zinc) GetReviews(productId string, retailer Retailer, options ProductOptions) (*ReviewsResponse, error) {
+	return z.GetReviewsContext(context.Background(), productId, retailer, options)
+}
+
+func (z Zinc) GetReviewsContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ReviewsResponse, error) {
+	values := url.Values{}
+	values.Set("retailer", string(retailer))
+	if options.Page != 0 {
+		values.Set("page", strconv.Itoa(options.Page))
+	}
+	if maxAge := z.maxAge(retailer, options); maxAge != 0 {
+		values.Set("max_age", strconv.Itoa(maxAge))
+	}
+	if !options.NewerThan.IsZero() {
+		values.Set("newer_than", strconv.FormatInt(options.NewerThan.Unix(), 10))
+	}
+	if options.Priority != 0 {
+		values.Set("priority", strconv.Itoa(options.Priority))
+	}
+	requestPath, err := z.endpoint(ctx, values, "products", productId, "reviews")
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	var resp ReviewsResponse
+	if decoded, err := z.sendRequest(ctx, "GET", requestPath, nil, z.productTimeout(options.Timeout), &resp); err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
+	}
+	return &resp, nil
+}
+
+const nonJSONSnippetBytes = 256
+
+func isNonJSONBody(contentType string, body []byte) bool {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '<' {
+		return true
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "text/html" || mediaType == "application/xhtml+xml"
+}
+
+func nonJSONError(statusCode int, contentType string, body []byte) ZincError {
+	snippet := bytes.TrimSpace(body)
+	if len(snippet) > nonJSONSnippetBytes {
+		snippet = snippet[:nonJSONSnippetBytes]
+	}
+	redactedSnippet := redactBody(snippet)
+	msg := fmt.Sprintf("Zinc API returned non-JSON response status=%d content_type=%v body=%v", statusCode, contentType, redactedSnippet)
+	return ZincError{ErrorMessage: msg, StatusCode: statusCode, Body: redactedSnippet}
+}
+
+func (z Zinc) maxResponseBytes() int64 {
+	if z.MaxResponseBytes > 0 {
+		return z.MaxResponseBytes
+	}
+	return defaultMaxResponseBytes
+}
+
+func readLimited(r io.Reader, maxBytes int64) ([]byte, error) {
+	body, err := ioutil.ReadAll(io.LimitReader(r, maxBytes+1))
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	if int64(len(body)) > maxBytes {
+		return nil, SimpleError(fmt.Sprintf("Zinc API response body exceeds limit of %d bytes", maxBytes))
+	}
+	return body, nil
+}
+
+func decompressBody(contentEncoding string, body []byte, maxBytes int64) ([]byte, error) {
+	switch strings.ToLower(strings.TrimSpace(contentEncoding)) {
+	case "", "identity":
+		return body, nil
+	case "gzip", "x-gzip":
+		gr, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		defer gr.Close()
+		return readLimited(gr, maxBytes)
+	case "deflate":
+		zr, err := zlib.NewReader(bytes.NewReader(body))
+		if err != nil {
+			// Some servers send raw DEFLATE without the zlib wrapper.
+			return readLimited(flate.NewReader(bytes.NewReader(body)), maxBytes)
+		}
+		defer zr.Close()
+		return readLimited(zr, maxBytes)
+	default:
+		return nil, SimpleError(fmt.Sprintf("Unsupported Content-Encoding %q", contentEncoding))
 	}
-	httpReq.SetBasicAuth(z.ZincUser, z.ZincPassword)
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+}
+
+// errEmptyBody marks an empty response body other than a 204, which only
+// endpoints such as abort may legitimately return.
+var errEmptyBody = errors.New("empty response body")
+
+func decodeRespBody(respBody []byte, resp interface{}) error {
+	return json.NewDecoder(bytes.NewReader(respBody)).Decode(resp)
+}
+
+func NormalizeBaseURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", SimpleError(fmt.Sprintf("Invalid base URL %q: %v", raw, err))
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", SimpleError(fmt.Sprintf("Invalid base URL %q: must be an absolute http or https URL", raw))
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", SimpleError(fmt.Sprintf("Invalid base URL %q: must not contain a query or fragment", raw))
 	}
-	client := &http.Client{Transport: tr, Timeout: timeout}
-	httpResp, err := client.Do(httpReq)
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+	return u.String(), nil
+}
+
+func (z *Zinc) SetBaseURL(raw string) error {
+	base, err := NormalizeBaseURL(raw)
 	if err != nil {
 		return err
 	}
+	z.ZincBaseURL = base
+	return nil
+}
+
+func (z Zinc) endpoint(ctx context.Context, query url.Values, elem ...string) (string, error) {
+	base := z.ZincBaseURL
+	if base == "" {
+		base = zincBaseURL
+	}
+	base, err := NormalizeBaseURL(base)
+	if err != nil {
+		return "", err
+	}
+	if version := z.apiVersion(ctx); version != "" {
+		base = apiVersionRegexp.ReplaceAllString(base, "") + "/" + version
+	}
+	requestPath := base
+	for _, segment := range elem {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", SimpleError(fmt.Sprintf("Invalid request path segment %q", segment))
+		}
+		requestPath += "/" + url.PathEscape(segment)
+	}
+	if len(query) > 0 {
+		requestPath += "?" + query.Encode()
+	}
+	return requestPath, nil
+}
+
+func (z Zinc) httpClient() *http.Client {
+	if z.HTTPClient != nil {
+		return z.HTTPClient
+	}
+	if z.InsecureSkipVerify {
+		return insecureHTTPClient
+	}
+	return defaultHTTPClient
+}
+
+func (z Zinc) maxAge(retailer Retailer, options ProductOptions) int {
+	if options.MaxAge != 0 || options.SkipCache {
+		return options.MaxAge
+	}
+	return z.RetailerMaxAge[retailer]
+}
+
+func (z Zinc) orderTimeout() time.Duration {
+	if z.DefaultTimeout > 0 {
+		return z.DefaultTimeout
+	}
+	return defaultOrderTimeout
+}
+
+func (z Zinc) productTimeout(timeout time.Duration) time.Duration {
+	if timeout > 0 {
+		return timeout
+	}
+	if z.DefaultTimeout > 0 {
+		return z.DefaultTimeout
+	}
+	return DefaultProductOptions.Timeout
+}
+
+func (z Zinc) userAgent() string {
+	if z.AppName != "" {
+		return fmt.Sprintf("golangsdk/%v (%v)", Version, z.AppName)
+	}
+	return "golangsdk/" + Version
+}
+
+func (z Zinc) Close() error {
+	z.httpClient().CloseIdleConnections()
+	var errs []error
+	for _, resource := range []interface{}{z.RateLimiter, z.Cache} {
+		if closer, ok := resource.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (z Zinc) logger() Logger {
+	if z.Logger != nil {
+		return z.Logger
+	}
+	if z.Slog != nil {
+		return slogPrintfLogger{z.Slog}
+	}
+	return noopLogger{}
+}
+
+func (z Zinc) SendRequest(method, requestPath string, body io.Reader, timeout time.Duration, resp interface{}) error {
+	return z.SendRequestContext(context.Background(), method, requestPath, body, timeout, resp)
+}
+
+func (z Zinc) SendRequestContext(ctx context.Context, method, requestPath string, body io.Reader, timeout time.Duration, resp interface{}) error {
+	_, err := z.sendRequest(ctx, method, requestPath, body, timeout, resp)
+	return err
+}
+
+// sendRequest is SendRequestContext, also reporting whether resp was
+// decoded so callers can return it alongside a failure reported in the
+// response body.
+func (z Zinc) sendRequest(ctx context.Context, method, requestPath string, body io.Reader, timeout time.Duration, resp interface{}) (decoded bool, err error) {
+	ctx, span := z.startSpan(ctx, method, requestPath)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+	}()
+	httpResp, err := z.roundTrip(ctx, span, method, requestPath, body, timeout)
+	if err != nil {
+		return false, err
+	}
+	if len(bytes.TrimSpace(httpResp.Body)) == 0 {
+		if httpResp.StatusCode == http.StatusNoContent {
+			return false, nil
+		}
+		return false, ZincError{
+			ErrorMessage: fmt.Sprintf("Zinc API returned an empty response body status=%d", httpResp.StatusCode),
+			StatusCode:   httpResp.StatusCode,
+			Err:          errEmptyBody,
+		}
+	}
+	if err := decodeRespBody(httpResp.Body, resp); err != nil {
+		redactedBody := redactBody(httpResp.Body)
+		z.logger().Printf("[Golangsdk] Unable to unmarshal response request_path=%v body=%v", requestPath, redactedBody)
+		zerr := WrapError(err)
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			zerr.ErrorMessage = fmt.Sprintf("Zinc API returned a truncated response body status=%d", httpResp.StatusCode)
+		}
+		zerr.StatusCode = httpResp.StatusCode
+		zerr.Body = redactedBody
+		return false, zerr
+	}
+	return true, checkResponse(resp)
+}
+
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+func (r *Response) Decode(v interface{}) error {
+	if len(bytes.TrimSpace(r.Body)) == 0 {
+		return nil
+	}
+	if err := decodeRespBody(r.Body, v); err != nil {
+		zerr := WrapError(err)
+		zerr.StatusCode = r.StatusCode
+		zerr.Body = redactBody(r.Body)
+		return zerr
+	}
+	return checkResponse(v)
+}
+
+func (z Zinc) Do(ctx context.Context, method, path string, body io.Reader) (resp *Response, err error) {
+	requestPath := path
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		base, err := z.endpoint(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		requestPath = base + "/" + strings.TrimLeft(path, "/")
+	}
+	ctx, span := z.startSpan(ctx, method, requestPath)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+	}()
+	return z.roundTrip(ctx, span, method, requestPath, body, z.orderTimeout())
+}
+
+func (z Zinc) roundTripOnce(ctx context.Context, span Span, method, requestPath string, payload []byte, timeout time.Duration) (*Response, error) {
+	if z.RateLimiter != nil {
+		if err := z.RateLimiter.Wait(ctx); err != nil {
+			return nil, WrapError(err)
+		}
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, method, requestPath, body)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	httpReq.SetBasicAuth(z.credentials(ctx))
+	httpReq.Header.Set("Accept-Encoding", "gzip, deflate")
+	httpReq.Header.Set("User-Agent", z.userAgent())
+	for _, hook := range z.RequestHooks {
+		if err := hook(httpReq); err != nil {
+			return nil, WrapError(err)
+		}
+	}
+	httpResp, err := z.httpClient().Do(httpReq)
+	if err != nil {
+		return nil, WrapError(err)
+	}
 	defer httpResp.Body.Close()
-	respBody, err := ioutil.ReadAll(httpResp.Body)
+	for _, hook := range z.ResponseHooks {
+		hook(httpResp)
+	}
+	captureResponseMetadata(ctx, requestPath, httpResp)
+	span.SetAttribute("http.status_code", httpResp.StatusCode)
+	maxBytes := z.maxResponseBytes()
+	respBody, err := readLimited(httpResp.Body, maxBytes)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	cleanedBody := cleanRespBody(respBody)
-	if err := json.Unmarshal(cleanedBody, resp); err != nil {
-		log.Printf("[Golangsdk] Unable to unmarshal response request_path=%v body=%v", requestPath, string(cleanedBody))
-		return SimpleError(err.Error())
+	respBody, err = decompressBody(httpResp.Header.Get("Content-Encoding"), respBody, maxBytes)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	if z.observesRequests() {
+		if requestId := peekRequestId(respBody); requestId != "" {
+			span.SetAttribute("zinc.request_id", requestId)
+		}
+	}
+	if z.RawResponseHook != nil {
+		z.RawResponseHook(requestPath, httpResp.StatusCode, respBody)
+	}
+	resp := &Response{
+		StatusCode: httpResp.StatusCode,
+		Header:     httpResp.Header,
+		Body:       respBody,
+	}
+	if isNonJSONBody(httpResp.Header.Get("Content-Type"), respBody) {
+		return resp, nonJSONError(httpResp.StatusCode, httpResp.Header.Get("Content-Type"), respBody)
+	}
+	if httpResp.StatusCode < 200 || httpResp.StatusCode > 299 {
+		return resp, httpStatusError(httpResp.StatusCode, respBody)
+	}
+	return resp, nil
 }
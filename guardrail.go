@@ -0,0 +1,31 @@
+package golangsdk
+
+import (
+	"context"
+	"fmt"
+)
+
+func (z Zinc) CheckOrderPrice(ctx context.Context, order OrderRequest) (int, error) {
+	total := 0
+	for _, product := range order.Products {
+		offers, err := z.GetProductOffersContext(ctx, product.ProductId, order.Retailer, ProductOptions{SkipCache: true})
+		if err != nil {
+			return 0, err
+		}
+		var filters []OfferFilter
+		if product.SellerSelectionCriteria != nil && product.SellerSelectionCriteria.Prime {
+			filters = append(filters, PrimeOnly)
+		}
+		offer, ok := offers.CheapestOffer(filters...)
+		if !ok {
+			msg := fmt.Sprintf("No available offer for product_id=%v", product.ProductId)
+			return 0, ZincError{Code: CodeProductUnavailable, ErrorMessage: msg}
+		}
+		total += offer.Quote(product.Quantity).Total
+	}
+	if order.MaxPrice > 0 && total > order.MaxPrice {
+		msg := fmt.Sprintf("Live price %v exceeds max_price %v", FormatPrice(total, order.Retailer), FormatPrice(order.MaxPrice, order.Retailer))
+		return total, ZincError{Code: CodeMaxPriceExceeded, ErrorMessage: msg}
+	}
+	return total, nil
+}
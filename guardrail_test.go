@@ -0,0 +1,110 @@
+package golangsdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestCheckOrderPriceBypassesCache(t *testing.T) {
+	var requests, price int32 = 0, 1000
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(golangsdk.ProductOffersResponse{
+			Status: "completed",
+			Offers: []golangsdk.ProductOffer{{Available: true, Price: int(atomic.LoadInt32(&price))}},
+		})
+	}))
+	defer srv.Close()
+
+	z, _ := golangsdk.NewZinc("user", "")
+	z.ZincBaseURL = srv.URL + "/v1"
+	z.Cache = golangsdk.NewMemoryCache()
+	order := golangsdk.OrderRequest{
+		Retailer: golangsdk.Amazon,
+		Products: []golangsdk.Product{{ProductId: "B07XJ8C8F5", Quantity: 1}},
+		MaxPrice: 1500,
+	}
+	if _, err := z.GetProductOffers("B07XJ8C8F5", golangsdk.Amazon, golangsdk.ProductOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	atomic.StoreInt32(&price, 2000)
+	total, err := z.CheckOrderPrice(context.Background(), order)
+	if err == nil {
+		t.Fatalf("CheckOrderPrice passed with total %d, want max_price error from live price", total)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("server saw %d requests, want 2", got)
+	}
+}
+
+func TestCheckOrderPriceShipsOnce(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(golangsdk.ProductOffersResponse{
+			Status: "completed",
+			Offers: []golangsdk.ProductOffer{{
+				Available:       true,
+				Price:           1000,
+				Seller:          golangsdk.Seller{FirstParty: true},
+				ShippingOptions: []golangsdk.ShippingOption{{Price: 500}},
+			}},
+		})
+	}))
+	defer srv.Close()
+
+	z, _ := golangsdk.NewZinc("user", "")
+	z.ZincBaseURL = srv.URL + "/v1"
+	order := golangsdk.OrderRequest{
+		Retailer: golangsdk.Amazon,
+		Products: []golangsdk.Product{{ProductId: "B07XJ8C8F5", Quantity: 3}},
+		MaxPrice: 3500,
+	}
+	total, err := z.CheckOrderPrice(context.Background(), order)
+	if err != nil {
+		t.Fatalf("CheckOrderPrice: %v", err)
+	}
+	if total != 3500 {
+		t.Errorf("total = %d, want 3500 with a single first-party shipment", total)
+	}
+}
+
+func TestCheckOrderPriceIgnoresRetailerMaxAge(t *testing.T) {
+	var maxAge string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		maxAge = r.URL.Query().Get("max_age")
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(golangsdk.ProductOffersResponse{
+			Status: "completed",
+			Offers: []golangsdk.ProductOffer{{Available: true, Price: 1000}},
+		})
+	}))
+	defer srv.Close()
+
+	z, _ := golangsdk.NewZinc("user", "")
+	z.ZincBaseURL = srv.URL + "/v1"
+	z.RetailerMaxAge = map[golangsdk.Retailer]int{golangsdk.Amazon: 3600}
+	if _, err := z.GetProductOffers("B07XJ8C8F5", golangsdk.Amazon, golangsdk.ProductOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if maxAge != "3600" {
+		t.Fatalf("GetProductOffers max_age = %q, want the retailer default 3600", maxAge)
+	}
+	order := golangsdk.OrderRequest{
+		Retailer: golangsdk.Amazon,
+		Products: []golangsdk.Product{{ProductId: "B07XJ8C8F5", Quantity: 1}},
+		MaxPrice: 1500,
+	}
+	if _, err := z.CheckOrderPrice(context.Background(), order); err != nil {
+		t.Fatal(err)
+	}
+	if maxAge != "" {
+		t.Errorf("CheckOrderPrice sent max_age=%v, want none", maxAge)
+	}
+}
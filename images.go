@@ -0,0 +1,26 @@
+package golangsdk
+
+import "regexp"
+
+var amazonImageSizeRegexp = regexp.MustCompile(`\._[^/]*_(\.[A-Za-z]+)$`)
+
+func HighResImageURL(imageURL string) string {
+	return amazonImageSizeRegexp.ReplaceAllString(imageURL, "$1")
+}
+
+func (p *ProductDetailsResponse) GetProductImages() []string {
+	seen := make(map[string]bool, len(p.Images)+1)
+	var images []string
+	for _, image := range append([]string{p.MainImage}, p.Images...) {
+		if image == "" {
+			continue
+		}
+		image = HighResImageURL(image)
+		if seen[image] {
+			continue
+		}
+		seen[image] = true
+		images = append(images, image)
+	}
+	return images
+}
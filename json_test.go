@@ -0,0 +1,198 @@
+package golangsdk_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zincio/golangsdk"
+	"github.com/zincio/golangsdk/zinctest"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files")
+
+func TestDecodeFixtures(t *testing.T) {
+	tests := []struct {
+		fixture string
+		target  interface{}
+		absent  []string
+	}{
+		{
+			fixture: "product_offers.json",
+			target:  &golangsdk.ProductOffersResponse{},
+			absent:  []string{"code", "data", "has_more"},
+		},
+		{
+			fixture: "product_details.json",
+			target:  &golangsdk.ProductDetailsResponse{},
+			absent:  []string{"code", "data"},
+		},
+		{
+			fixture: "order_response.json",
+			target:  &golangsdk.OrderResponse{},
+			absent:  []string{"code", "data", "message", "request"},
+		},
+		{
+			fixture: "order_error.json",
+			target:  &golangsdk.OrderResponse{},
+			absent:  []string{"data.all_variants", "price_components", "merchant_order_ids", "tracking", "request"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			dec := json.NewDecoder(bytes.NewReader(zinctest.Fixture(tt.fixture)))
+			dec.DisallowUnknownFields()
+			if err := dec.Decode(tt.target); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			missing := missingFields(reflect.ValueOf(tt.target), "")
+			sort.Strings(missing)
+			sort.Strings(tt.absent)
+			if !reflect.DeepEqual(missing, tt.absent) {
+				t.Errorf("unpopulated fields = %q, want %q", missing, tt.absent)
+			}
+		})
+	}
+}
+
+func TestOrderRequestGolden(t *testing.T) {
+	order := golangsdk.OrderRequest{
+		Retailer: golangsdk.Amazon,
+		Products: []golangsdk.Product{{
+			ProductId:               "B07XJ8C8F5",
+			Quantity:                2,
+			SellerSelectionCriteria: &golangsdk.SellerSelectionCriteria{Prime: true},
+		}},
+		ShippingMethod: golangsdk.ShippingCheapest,
+		ShippingAddress: &golangsdk.Address{
+			FirstName:    "Tim",
+			LastName:     "Beaver",
+			AddressLine1: "77 Massachusetts Avenue",
+			ZipCode:      "02139",
+			City:         "Cambridge",
+			State:        "MA",
+			Country:      "US",
+			PhoneNumber:  "5551230101",
+		},
+		MaxPrice:       6000,
+		ClientNotes:    map[string]interface{}{"our_internal_order_id": "abc123"},
+		IdempotencyKey: "6f1e2d3c4b5a69788796a5b4c3d2e1f0",
+	}
+	got, err := json.MarshalIndent(order, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, '\n')
+	golden := filepath.Join("zinctest", "testdata", "order_request.json")
+	if *update {
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("marshaled OrderRequest does not match %v:\n%s", golden, got)
+	}
+}
+
+func TestMinimalOrderRequestJSON(t *testing.T) {
+	order := golangsdk.OrderRequest{
+		Retailer: golangsdk.Amazon,
+		Products: []golangsdk.Product{{ProductId: "B07XJ8C8F5", Quantity: 1}},
+		ShippingAddress: &golangsdk.Address{
+			FirstName:    "Tim",
+			LastName:     "Beaver",
+			AddressLine1: "77 Massachusetts Avenue",
+			ZipCode:      "02139",
+			City:         "Cambridge",
+			State:        "MA",
+			Country:      "US",
+			PhoneNumber:  "5551230101",
+		},
+	}
+	got, err := json.Marshal(order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"retailer":"amazon","products":[{"product_id":"B07XJ8C8F5","quantity":1,"fresh":false}],` +
+		`"shipping_address":{"first_name":"Tim","last_name":"Beaver","address_line1":"77 Massachusetts Avenue",` +
+		`"address_line2":"","zip_code":"02139","city":"Cambridge","state":"MA","country":"US","phone_number":"5551230101"}}`
+	if string(got) != want {
+		t.Errorf("minimal order JSON:\n got %s\nwant %s", got, want)
+	}
+	for _, field := range []string{"is_gift", "max_price", "bundled", "addax"} {
+		if bytes.Contains(got, []byte(`"`+field+`"`)) {
+			t.Errorf("unset %v was serialized", field)
+		}
+	}
+}
+
+// missingFields returns the JSON paths of fields left at their zero value.
+// A slice element field counts as populated if any element populates it.
+func missingFields(v reflect.Value, path string) []string {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return []string{path}
+		}
+		return missingFields(v.Elem(), path)
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			if v.Interface().(time.Time).IsZero() {
+				return []string{path}
+			}
+			return nil
+		}
+		if path != "" && v.IsZero() {
+			return []string{path}
+		}
+		var missing []string
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if !field.IsExported() || name == "-" {
+				continue
+			}
+			if path != "" {
+				name = path + "." + name
+			}
+			missing = append(missing, missingFields(v.Field(i), name)...)
+		}
+		return missing
+	case reflect.Slice, reflect.Map:
+		if v.Len() == 0 {
+			return []string{path}
+		}
+		if v.Kind() == reflect.Map {
+			return nil
+		}
+		counts := make(map[string]int)
+		for i := 0; i < v.Len(); i++ {
+			for _, m := range missingFields(v.Index(i), path) {
+				counts[m]++
+			}
+		}
+		var missing []string
+		for m, n := range counts {
+			if n == v.Len() {
+				missing = append(missing, m)
+			}
+		}
+		return missing
+	default:
+		if v.IsZero() {
+			return []string{path}
+		}
+		return nil
+	}
+}
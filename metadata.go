@@ -0,0 +1,83 @@
+package golangsdk
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// ResponseMetadata records the status and headers of the responses made
+// with a context from WithResponseMetadata. Calls that fan out, such as
+// GetProductInfo or GetProductInfoBatch, record every response, returned
+// by All; Status and Headers report the last one to complete. All methods
+// are safe to call while such a call is in flight.
+type ResponseMetadata struct {
+	mu         sync.Mutex
+	statusCode int
+	header     http.Header
+	responses  []ResponseInfo
+}
+
+type ResponseInfo struct {
+	RequestPath string
+	StatusCode  int
+	Header      http.Header
+}
+
+type responseMetadataContextKey struct{}
+
+func WithResponseMetadata(ctx context.Context, metadata *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, responseMetadataContextKey{}, metadata)
+}
+
+func captureResponseMetadata(ctx context.Context, requestPath string, httpResp *http.Response) {
+	metadata, ok := ctx.Value(responseMetadataContextKey{}).(*ResponseMetadata)
+	if !ok || metadata == nil {
+		return
+	}
+	header := httpResp.Header.Clone()
+	metadata.mu.Lock()
+	defer metadata.mu.Unlock()
+	metadata.statusCode = httpResp.StatusCode
+	metadata.header = header
+	metadata.responses = append(metadata.responses, ResponseInfo{
+		RequestPath: requestPath,
+		StatusCode:  httpResp.StatusCode,
+		Header:      header,
+	})
+}
+
+func (m *ResponseMetadata) Status() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.statusCode
+}
+
+func (m *ResponseMetadata) Headers() http.Header {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.header.Clone()
+}
+
+func (m *ResponseMetadata) All() []ResponseInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ResponseInfo(nil), m.responses...)
+}
+
+func (m *ResponseMetadata) RequestId() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.header.Get("X-Request-Id")
+}
+
+func (m *ResponseMetadata) RateLimitRemaining() (int, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	remaining, err := strconv.Atoi(m.header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return 0, false
+	}
+	return remaining, true
+}
@@ -0,0 +1,145 @@
+package golangsdk
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+type Money struct {
+	Amount   int
+	Currency string
+}
+
+type currencyFormat struct {
+	symbol      string
+	suffix      bool
+	minorDigits int
+	groupSep    string
+	decimalSep  string
+}
+
+var currencyFormats = map[string]currencyFormat{
+	"USD": {symbol: "$", minorDigits: 2, groupSep: ",", decimalSep: "."},
+	"CAD": {symbol: "CA$", minorDigits: 2, groupSep: ",", decimalSep: "."},
+	"MXN": {symbol: "MX$", minorDigits: 2, groupSep: ",", decimalSep: "."},
+	"GBP": {symbol: "£", minorDigits: 2, groupSep: ",", decimalSep: "."},
+	"EUR": {symbol: "€", suffix: true, minorDigits: 2, groupSep: ".", decimalSep: ","},
+	"JPY": {symbol: "¥", minorDigits: 0, groupSep: ","},
+}
+
+var retailerCurrencies = map[Retailer]string{
+	Amazon:     "USD",
+	AmazonUK:   "GBP",
+	AmazonCA:   "CAD",
+	AmazonMX:   "MXN",
+	AmazonDE:   "EUR",
+	AmazonFR:   "EUR",
+	AmazonIT:   "EUR",
+	AmazonES:   "EUR",
+	AmazonJP:   "JPY",
+	Walmart:    "USD",
+	Aliexpress: "USD",
+}
+
+func (r Retailer) Currency() string {
+	if currency, ok := retailerCurrencies[r]; ok {
+		return currency
+	}
+	return "USD"
+}
+
+func (r Retailer) Money(amount int) Money {
+	return Money{Amount: amount, Currency: r.Currency()}
+}
+
+func FormatPrice(amount int, retailer Retailer) string {
+	return retailer.Money(amount).String()
+}
+
+// Dollars returns the amount in major units of its currency, so JPY
+// amounts, which have no minor unit, are returned as is.
+func (m Money) Dollars() float64 {
+	_, format, _ := m.format()
+	return float64(m.Amount) / float64(format.divisor())
+}
+
+func (m Money) format() (string, currencyFormat, bool) {
+	currency := strings.ToUpper(m.Currency)
+	if currency == "" {
+		currency = "USD"
+	}
+	format, known := currencyFormats[currency]
+	if !known {
+		format = currencyFormat{minorDigits: 2, groupSep: ",", decimalSep: "."}
+	}
+	return currency, format, known
+}
+
+func (f currencyFormat) divisor() int {
+	divisor := 1
+	for i := 0; i < f.minorDigits; i++ {
+		divisor *= 10
+	}
+	return divisor
+}
+
+func (m Money) String() string {
+	currency, format, known := m.format()
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	divisor := format.divisor()
+	value := groupDigits(strconv.Itoa(amount/divisor), format.groupSep)
+	if format.minorDigits > 0 {
+		minor := strconv.Itoa(amount % divisor)
+		value += format.decimalSep + strings.Repeat("0", format.minorDigits-len(minor)) + minor
+	}
+
+	switch {
+	case !known:
+		return sign + value + " " + currency
+	case format.suffix:
+		return sign + value + " " + format.symbol
+	default:
+		return sign + format.symbol + value
+	}
+}
+
+func groupDigits(digits, sep string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	var b strings.Builder
+	head := len(digits) % 3
+	if head > 0 {
+		b.WriteString(digits[:head])
+	}
+	for i := head; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Amount)
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &m.Amount)
+}
+
+func (o ProductOffer) PriceMoney() Money {
+	return Money{Amount: o.Price, Currency: o.Currency}
+}
+
+func (p PriceComponents) TotalMoney(currency string) Money {
+	return Money{Amount: p.Total, Currency: currency}
+}
@@ -0,0 +1,26 @@
+package golangsdk_test
+
+import (
+	"testing"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestMoneyDollars(t *testing.T) {
+	tests := []struct {
+		money golangsdk.Money
+		want  float64
+	}{
+		{golangsdk.Money{Amount: 1299, Currency: "USD"}, 12.99},
+		{golangsdk.Money{Amount: 1299}, 12.99},
+		{golangsdk.Money{Amount: 1299, Currency: "eur"}, 12.99},
+		{golangsdk.Money{Amount: 1500, Currency: "JPY"}, 1500},
+		{golangsdk.AmazonJP.Money(980), 980},
+		{golangsdk.Money{Amount: 1299, Currency: "XYZ"}, 12.99},
+	}
+	for _, tt := range tests {
+		if got := tt.money.Dollars(); got != tt.want {
+			t.Errorf("%+v.Dollars() = %v, want %v", tt.money, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package golangsdk
+
+import "sort"
+
+type OfferFilter func(ProductOffer) bool
+
+func PrimeOnly(o ProductOffer) bool {
+	return o.PrimeOnly
+}
+
+func FirstPartyOnly(o ProductOffer) bool {
+	return o.Seller.FirstParty
+}
+
+func DomesticOnly(o ProductOffer) bool {
+	return !o.International
+}
+
+func NewCondition(o ProductOffer) bool {
+	return o.ParsedCondition() == ConditionNew
+}
+
+func MarketplaceFulfilled(o ProductOffer) bool {
+	return o.MarketplaceFulfilled
+}
+
+func MinSellerRating(percentPositive int) OfferFilter {
+	return func(o ProductOffer) bool {
+		return o.Seller.PercentPositive >= percentPositive
+	}
+}
+
+func AllOf(filters ...OfferFilter) OfferFilter {
+	return func(o ProductOffer) bool {
+		for _, filter := range filters {
+			if !filter(o) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func (o ProductOffer) CheapestShipping() (int, bool) {
+	if len(o.ShippingOptions) == 0 {
+		return 0, false
+	}
+	cheapest := o.ShippingOptions[0].Price
+	for _, option := range o.ShippingOptions[1:] {
+		if option.Price < cheapest {
+			cheapest = option.Price
+		}
+	}
+	return cheapest, true
+}
+
+func (o ProductOffer) TotalPrice() int {
+	shipping, _ := o.CheapestShipping()
+	return o.Price + shipping
+}
+
+type OfferQuote struct {
+	Quantity int
+	Subtotal int
+	Shipping int
+	Total    int
+}
+
+// Quote prices quantity units of the offer. Third-party sellers that ship
+// their own inventory charge shipping per unit; first-party and
+// marketplace-fulfilled offers ship in a single shipment.
+func (o ProductOffer) Quote(quantity int) OfferQuote {
+	if quantity < 1 {
+		quantity = 1
+	}
+	shipping, _ := o.CheapestShipping()
+	if !o.Seller.FirstParty && !o.MarketplaceFulfilled {
+		shipping *= quantity
+	}
+	subtotal := o.Price * quantity
+	return OfferQuote{
+		Quantity: quantity,
+		Subtotal: subtotal,
+		Shipping: shipping,
+		Total:    subtotal + shipping,
+	}
+}
+
+func (r *ProductOffersResponse) CheapestOffer(filters ...OfferFilter) (*ProductOffer, bool) {
+	match := AllOf(filters...)
+	var cheapest *ProductOffer
+	for i := range r.Offers {
+		offer := &r.Offers[i]
+		if !offer.Available || !match(*offer) {
+			continue
+		}
+		if cheapest == nil || offer.TotalPrice() < cheapest.TotalPrice() {
+			cheapest = offer
+		}
+	}
+	return cheapest, cheapest != nil
+}
+
+func (r *ProductOffersResponse) Filter(pred OfferFilter) []ProductOffer {
+	var offers []ProductOffer
+	for _, offer := range r.Offers {
+		if pred(offer) {
+			offers = append(offers, offer)
+		}
+	}
+	return offers
+}
+
+func (r *ProductOffersResponse) applyFilters(filters []OfferFilter) {
+	if len(filters) == 0 {
+		return
+	}
+	r.Offers = r.Filter(AllOf(filters...))
+}
+
+func (r *ProductOffersResponse) SortByTotalPrice() {
+	sort.SliceStable(r.Offers, func(i, j int) bool {
+		return r.Offers[i].TotalPrice() < r.Offers[j].TotalPrice()
+	})
+}
+
+type offerKey struct {
+	sellerId  string
+	condition Condition
+	price     int
+}
+
+func (r *ProductOffersResponse) Dedupe() {
+	index := make(map[offerKey]int, len(r.Offers))
+	deduped := r.Offers[:0]
+	for _, offer := range r.Offers {
+		key := offerKey{
+			sellerId:  offer.Seller.Id,
+			condition: offer.ParsedCondition(),
+			price:     offer.Price,
+		}
+		if i, ok := index[key]; ok {
+			if offer.BuyBoxWinner && !deduped[i].BuyBoxWinner {
+				deduped[i] = offer
+			}
+			continue
+		}
+		index[key] = len(deduped)
+		deduped = append(deduped, offer)
+	}
+	r.Offers = deduped
+}
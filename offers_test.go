@@ -0,0 +1,38 @@
+package golangsdk_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestGetAllProductOffersFiltersAfterPaging(t *testing.T) {
+	pages := map[string]golangsdk.ProductOffersResponse{
+		"1": {Status: "completed", Page: 1, HasMore: true, Offers: []golangsdk.ProductOffer{
+			{Available: true, OfferId: "non-prime", Price: 1000},
+		}},
+		"2": {Status: "completed", Page: 2, Offers: []golangsdk.ProductOffer{
+			{Available: true, OfferId: "prime", PrimeOnly: true, Price: 1200},
+		}},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(pages[r.URL.Query().Get("page")])
+	}))
+	defer srv.Close()
+
+	z, _ := golangsdk.NewZinc("user", "")
+	z.ZincBaseURL = srv.URL + "/v1"
+	resp, err := z.GetAllProductOffers("B07XJ8C8F5", golangsdk.Amazon, golangsdk.ProductOptions{
+		OfferFilters: []golangsdk.OfferFilter{golangsdk.PrimeOnly},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Offers) != 1 || resp.Offers[0].OfferId != "prime" {
+		t.Fatalf("offers = %+v, want only the prime offer from page 2", resp.Offers)
+	}
+}
@@ -0,0 +1,70 @@
+package golangsdk
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	defaultPollInterval   = time.Second * 5
+	maxPollIntervalFactor = 12
+)
+
+func (z Zinc) PollOrder(ctx context.Context, requestId string, interval time.Duration) (*OrderResponse, error) {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	wait := interval
+	for {
+		resp, err := z.GetOrderStatusContext(ctx, requestId)
+		if err != nil {
+			return resp, err
+		}
+		if !resp.IsProcessing() {
+			return resp, nil
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return resp, err
+		}
+		wait = nextPollInterval(wait, interval)
+	}
+}
+
+func (z Zinc) WaitForTracking(ctx context.Context, requestId string, interval time.Duration) ([]Tracking, error) {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	wait := interval
+	for {
+		resp, err := z.GetOrderStatusContext(ctx, requestId)
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Tracking) > 0 {
+			return resp.Tracking, nil
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+		wait = nextPollInterval(wait, interval)
+	}
+}
+
+func nextPollInterval(current, base time.Duration) time.Duration {
+	next := current * 3 / 2
+	if max := base * maxPollIntervalFactor; next > max {
+		return max
+	}
+	return next
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,89 @@
+package golangsdk
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	asinRegexp         = regexp.MustCompile(`^[A-Z0-9]{10}$`)
+	walmartItemRegexp  = regexp.MustCompile(`^\d{5,12}$`)
+	aliexpressIdRegexp = regexp.MustCompile(`^\d{6,20}$`)
+
+	amazonURLASINRegexp  = regexp.MustCompile(`(?i)/(?:dp|gp/product|gp/aw/d|exec/obidos/asin|o/asin)/([A-Z0-9]{10})(?:[/?]|$)`)
+	walmartURLItemRegexp = regexp.MustCompile(`/ip/(?:[^/]+/)?(\d{5,12})(?:[/?]|$)`)
+	aliexpressURLRegexp  = regexp.MustCompile(`/item/(?:[^/]+/)?(\d{6,20})\.html`)
+)
+
+func (r Retailer) isAmazon() bool {
+	return strings.HasPrefix(string(r), string(Amazon))
+}
+
+func ExtractASIN(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if id := strings.ToUpper(s); asinRegexp.MatchString(id) {
+		return id, true
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return "", false
+	}
+	if m := amazonURLASINRegexp.FindStringSubmatch(u.Path); m != nil {
+		return strings.ToUpper(m[1]), true
+	}
+	return "", false
+}
+
+func ValidProductId(retailer Retailer, productId string) bool {
+	switch {
+	case retailer.isAmazon():
+		return asinRegexp.MatchString(productId)
+	case retailer == Walmart:
+		return walmartItemRegexp.MatchString(productId)
+	case retailer == Aliexpress:
+		return aliexpressIdRegexp.MatchString(productId)
+	default:
+		return productId != ""
+	}
+}
+
+// canonicalProductId upper-cases ASINs, which Amazon treats
+// case-insensitively; other retailers' ids are returned unchanged.
+func canonicalProductId(retailer Retailer, productId string) string {
+	if retailer.isAmazon() {
+		return strings.ToUpper(productId)
+	}
+	return productId
+}
+
+func NormalizeProductId(retailer Retailer, raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	id := raw
+	switch {
+	case retailer.isAmazon():
+		if asin, ok := ExtractASIN(raw); ok {
+			id = asin
+		}
+	case retailer == Walmart:
+		id = extractFromURL(raw, walmartURLItemRegexp)
+	case retailer == Aliexpress:
+		id = extractFromURL(raw, aliexpressURLRegexp)
+	}
+	if !ValidProductId(retailer, id) {
+		return "", SimpleError(fmt.Sprintf("Invalid %v product id %q", retailer, raw))
+	}
+	return id, nil
+}
+
+func extractFromURL(raw string, re *regexp.Regexp) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+	if m := re.FindStringSubmatch(u.Path); m != nil {
+		return m[1]
+	}
+	return raw
+}
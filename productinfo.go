@@ -0,0 +1,103 @@
+package golangsdk
+
+import (
+	"context"
+	"sync"
+)
+
+type ProductInfo struct {
+	Offers     *ProductOffersResponse
+	Details    *ProductDetailsResponse
+	OffersErr  error
+	DetailsErr error
+}
+
+type ProductSummary struct {
+	ProductId string
+	Retailer  string
+	Title     string
+	Brand     string
+	MainImage string
+}
+
+func (p *ProductDetailsResponse) Summary() ProductSummary {
+	return ProductSummary{
+		ProductId: p.ProductId,
+		Retailer:  p.Retailer,
+		Title:     p.Title,
+		Brand:     p.Brand,
+		MainImage: p.MainImage,
+	}
+}
+
+func (p *ProductInfo) Title() string {
+	if p.Details == nil {
+		return ""
+	}
+	return p.Details.Title
+}
+
+func (p *ProductInfo) MainImage() string {
+	if p.Details == nil {
+		return ""
+	}
+	return p.Details.MainImage
+}
+
+func (p *ProductInfo) CheapestOffer(filters ...OfferFilter) (*ProductOffer, bool) {
+	if p.Offers == nil {
+		return nil, false
+	}
+	return p.Offers.CheapestOffer(filters...)
+}
+
+func (z Zinc) GetProduct(productId string, retailer Retailer, options ProductOptions) (*ProductInfo, error) {
+	return z.GetProductContext(context.Background(), productId, retailer, options)
+}
+
+func (z Zinc) GetProductContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ProductInfo, error) {
+	offers, details, err := z.GetProductInfoContext(ctx, productId, retailer, options)
+	if err != nil {
+		return nil, err
+	}
+	return &ProductInfo{Offers: offers, Details: details}, nil
+}
+
+func (z Zinc) GetProductPartial(productId string, retailer Retailer, options ProductOptions) (*ProductInfo, error) {
+	return z.GetProductPartialContext(context.Background(), productId, retailer, options)
+}
+
+func (z Zinc) GetProductPartialContext(ctx context.Context, productId string, retailer Retailer, options ProductOptions) (*ProductInfo, error) {
+	var (
+		wg   sync.WaitGroup
+		info ProductInfo
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if offers, err := z.GetProductOffersContext(ctx, productId, retailer, options); err != nil {
+			info.OffersErr = err
+		} else {
+			info.Offers = offers
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if details, err := z.GetProductDetailsContext(ctx, productId, retailer, options); err != nil {
+			info.DetailsErr = err
+		} else {
+			info.Details = details
+		}
+	}()
+	wg.Wait()
+
+	switch {
+	case info.OffersErr != nil && info.DetailsErr != nil:
+		return nil, productInfoError(info.OffersErr, info.DetailsErr)
+	case info.OffersErr != nil:
+		return &info, info.OffersErr
+	case info.DetailsErr != nil:
+		return &info, info.DetailsErr
+	}
+	return &info, nil
+}
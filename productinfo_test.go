@@ -0,0 +1,38 @@
+package golangsdk_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/zincio/golangsdk"
+	"github.com/zincio/golangsdk/zinctest"
+)
+
+func TestGetProductInfoReportsBothErrors(t *testing.T) {
+	s := zinctest.NewServer()
+	defer s.Close()
+	z := s.Client()
+	z.RequestHooks = []func(*http.Request) error{
+		func(r *http.Request) error {
+			if strings.HasSuffix(r.URL.Path, "/offers") {
+				return errors.New("offers backend down")
+			}
+			return errors.New("details backend down")
+		},
+	}
+
+	offers, details, err := z.GetProductInfo("B07XJ8C8F5", golangsdk.Amazon, golangsdk.ProductOptions{})
+	if err == nil {
+		t.Fatal("GetProductInfo succeeded, want error")
+	}
+	if offers != nil || details != nil {
+		t.Errorf("got offers=%v details=%v, want nil results", offers, details)
+	}
+	for _, want := range []string{"offers backend down", "details backend down"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package golangsdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const redactedPlaceholder = "[REDACTED]"
+
+var cardNumberRegexp = regexp.MustCompile(`\b\d{12,19}\b`)
+
+var redactedKeys = map[string]bool{
+	"security_code":     true,
+	"password":          true,
+	"verification_code": true,
+	"totp_2fa_key":      true,
+	"session_cookies":   true,
+}
+
+type paymentMethodNoString PaymentMethod
+
+type retailerCredentialsNoString RetailerCredentials
+
+func maskCardNumber(number string) string {
+	if len(number) <= 4 {
+		return strings.Repeat("*", len(number))
+	}
+	return strings.Repeat("*", len(number)-4) + number[len(number)-4:]
+}
+
+func (p PaymentMethod) Redacted() PaymentMethod {
+	p.Number = maskCardNumber(p.Number)
+	if p.SecurityCode != "" {
+		p.SecurityCode = redactedPlaceholder
+	}
+	return p
+}
+
+func (p PaymentMethod) String() string {
+	return fmt.Sprintf("%+v", paymentMethodNoString(p.Redacted()))
+}
+
+func (c RetailerCredentials) Redacted() RetailerCredentials {
+	if c.Password != "" {
+		c.Password = redactedPlaceholder
+	}
+	if c.VerificationCode != "" {
+		c.VerificationCode = redactedPlaceholder
+	}
+	if c.Totp2FAKey != "" {
+		c.Totp2FAKey = redactedPlaceholder
+	}
+	if c.SessionCookies != "" {
+		c.SessionCookies = redactedPlaceholder
+	}
+	return c
+}
+
+func (c RetailerCredentials) String() string {
+	return fmt.Sprintf("%+v", retailerCredentialsNoString(c.Redacted()))
+}
+
+func (o OrderRequest) Redacted() OrderRequest {
+	if o.PaymentMethod != nil {
+		pm := o.PaymentMethod.Redacted()
+		o.PaymentMethod = &pm
+	}
+	if o.RetailerCredentials != nil {
+		rc := o.RetailerCredentials.Redacted()
+		o.RetailerCredentials = &rc
+	}
+	return o
+}
+
+func redactBody(body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return cardNumberRegexp.ReplaceAllStringFunc(string(body), maskCardNumber)
+	}
+	redacted, err := json.Marshal(redactValue("", v))
+	if err != nil {
+		return cardNumberRegexp.ReplaceAllStringFunc(string(body), maskCardNumber)
+	}
+	return string(redacted)
+}
+
+func redactValue(key string, v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, child := range val {
+			val[k] = redactValue(k, child)
+		}
+		return val
+	case []interface{}:
+		for i, child := range val {
+			val[i] = redactValue(key, child)
+		}
+		return val
+	case string:
+		if redactedKeys[key] && val != "" {
+			return redactedPlaceholder
+		}
+		if key == "number" {
+			return maskCardNumber(val)
+		}
+		return cardNumberRegexp.ReplaceAllStringFunc(val, maskCardNumber)
+	default:
+		return v
+	}
+}
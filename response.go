@@ -0,0 +1,73 @@
+package golangsdk
+
+import "fmt"
+
+// apiResponse is implemented by response types whose body can report a
+// failure even though the HTTP status was 200.
+type apiResponse interface {
+	responseError() error
+}
+
+func checkResponse(resp interface{}) error {
+	if r, ok := resp.(apiResponse); ok {
+		return r.responseError()
+	}
+	return nil
+}
+
+func responseError(typ, code, status, message string, data *ErrorDataResponse) error {
+	switch {
+	case typ == "error" && code == CodeRequestProcessing:
+		return nil
+	case typ == "error":
+		return apiError(code, message, data)
+	case status == "failed":
+		zerr := ZincError{Code: code}
+		if data != nil {
+			zerr.Data = *data
+		}
+		zerr.ErrorMessage = fmt.Sprintf("Zinc API returned status 'failed' data=%+v", zerr.Data)
+		return zerr
+	}
+	return nil
+}
+
+func (o *OrderResponse) responseError() error {
+	return responseError(o.Type, o.Code, "", o.ErrorMessage, o.Data)
+}
+
+func (r *OrderListResponse) responseError() error {
+	return responseError(r.Type, r.Code, "", r.ErrorMessage, r.Data)
+}
+
+func (r *ReturnResponse) responseError() error {
+	return responseError(r.Type, r.Code, "", r.ErrorMessage, r.Data)
+}
+
+func (r *CaseResponse) responseError() error {
+	return responseError(r.Type, r.Code, "", r.ErrorMessage, r.Data)
+}
+
+func (r *BalanceResponse) responseError() error {
+	return responseError(r.Type, r.Code, "", r.ErrorMessage, r.Data)
+}
+
+func (r *AddressesResponse) responseError() error {
+	return responseError(r.Type, r.Code, "", r.ErrorMessage, r.Data)
+}
+
+func (r *ProductOffersResponse) responseError() error {
+	return responseError("", r.Code, r.Status, "", &r.Data)
+}
+
+func (r *ProductDetailsResponse) responseError() error {
+	return responseError("", r.Code, r.Status, "", &r.Data)
+}
+
+func (r *ProductSearchResponse) responseError() error {
+	return responseError("", r.Code, r.Status, "", &r.Data)
+}
+
+func (r *ReviewsResponse) responseError() error {
+	return responseError("", r.Code, r.Status, "", &r.Data)
+}
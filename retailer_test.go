@@ -0,0 +1,31 @@
+package golangsdk_test
+
+import (
+	"testing"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestGetRetailerInvalid(t *testing.T) {
+	for _, input := range []string{"", "bogus", "Amazon", "amazon ", "amazon_us"} {
+		r, err := golangsdk.GetRetailer(input)
+		if err == nil {
+			t.Errorf("GetRetailer(%q): expected an error", input)
+		}
+		if r != "" {
+			t.Errorf("GetRetailer(%q) = %q, want the zero Retailer", input, r)
+		}
+	}
+}
+
+func TestMustGetRetailer(t *testing.T) {
+	if r := golangsdk.MustGetRetailer("amazon"); r != golangsdk.Amazon {
+		t.Errorf("MustGetRetailer(amazon) = %q, want %q", r, golangsdk.Amazon)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustGetRetailer(bogus) did not panic")
+		}
+	}()
+	golangsdk.MustGetRetailer("bogus")
+}
@@ -0,0 +1,94 @@
+package golangsdk
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRateLimitRetries = 3
+	defaultMaxRetryAfter    = time.Minute
+	rateLimitBackoffBase    = time.Second
+)
+
+func (z Zinc) roundTrip(ctx context.Context, span Span, method, requestPath string, body io.Reader, timeout time.Duration) (*Response, error) {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = ioutil.ReadAll(body); err != nil {
+			return nil, WrapError(err)
+		}
+	}
+	for attempt := 0; ; attempt++ {
+		resp, err := z.roundTripOnce(ctx, span, method, requestPath, payload, timeout)
+		if resp == nil || resp.StatusCode != http.StatusTooManyRequests || attempt >= z.rateLimitRetries() {
+			return resp, err
+		}
+		wait := z.rateLimitWait(resp.Header.Get("Retry-After"), attempt, time.Now())
+		z.logger().Printf("[Golangsdk] Rate limited, retrying request_path=%v attempt=%d wait=%v", requestPath, attempt+1, wait)
+		span.SetAttribute("zinc.rate_limit_retries", attempt+1)
+		if err := sleepContext(ctx, wait); err != nil {
+			return resp, WrapError(err)
+		}
+	}
+}
+
+func (z Zinc) rateLimitRetries() int {
+	switch {
+	case z.MaxRateLimitRetries < 0:
+		return 0
+	case z.MaxRateLimitRetries == 0:
+		return defaultRateLimitRetries
+	default:
+		return z.MaxRateLimitRetries
+	}
+}
+
+func (z Zinc) rateLimitWait(retryAfter string, attempt int, now time.Time) time.Duration {
+	maxWait := z.MaxRetryAfter
+	if maxWait <= 0 {
+		maxWait = defaultMaxRetryAfter
+	}
+	wait, ok := parseRetryAfter(retryAfter, now)
+	if !ok {
+		backoff := rateLimitBackoffBase
+		for i := 0; i < attempt && backoff <= maxWait/2; i++ {
+			backoff *= 2
+		}
+		if backoff > maxWait {
+			backoff = maxWait
+		}
+		wait = backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+	}
+	if wait > maxWait {
+		return maxWait
+	}
+	return wait
+}
+
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	at, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if wait := at.Sub(now); wait > 0 {
+		return wait, true
+	}
+	return 0, true
+}
@@ -0,0 +1,32 @@
+package golangsdk_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestRateLimitBackoffManyRetries(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	z, _ := golangsdk.NewZinc("user", "")
+	z.ZincBaseURL = srv.URL + "/v1"
+	z.MaxRateLimitRetries = 70
+	z.MaxRetryAfter = time.Millisecond
+
+	if _, err := z.GetBalance(); err == nil {
+		t.Fatal("GetBalance: expected an error after exhausting retries")
+	}
+	if got := hits.Load(); got != 71 {
+		t.Errorf("server hits = %d, want 71", got)
+	}
+}
@@ -0,0 +1,96 @@
+package golangsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+type ReturnReason string
+
+const (
+	ReturnReasonDamaged        ReturnReason = "damaged"
+	ReturnReasonDefective      ReturnReason = "defective"
+	ReturnReasonWrongItem      ReturnReason = "wrong_item"
+	ReturnReasonNotAsDescribed ReturnReason = "not_as_described"
+	ReturnReasonNoLongerNeeded ReturnReason = "no_longer_needed"
+	ReturnReasonOther          ReturnReason = "other"
+)
+
+type ReturnMethod string
+
+const (
+	ReturnMethodUPSDropoff  ReturnMethod = "ups_dropoff"
+	ReturnMethodUPSPickup   ReturnMethod = "ups_pickup"
+	ReturnMethodUSPSDropoff ReturnMethod = "usps_dropoff"
+)
+
+type ReturnRequest struct {
+	MerchantOrderId string       `json:"merchant_order_id"`
+	ProductIds      []string     `json:"product_ids"`
+	ReasonCode      ReturnReason `json:"reason_code"`
+	MethodCode      ReturnMethod `json:"method_code"`
+	Explanation     string       `json:"explanation,omitempty"`
+	Webhooks        *Webhooks    `json:"webhooks,omitempty"`
+}
+
+type ReturnResponse struct {
+	RequestId        string             `json:"request_id"`
+	Type             string             `json:"_type"`
+	Code             string             `json:"code"`
+	Data             *ErrorDataResponse `json:"data,omitempty"`
+	ErrorMessage     string             `json:"message"`
+	MerchantReturnId string             `json:"merchant_return_id"`
+	LabelURLs        []string           `json:"label_urls"`
+	Carrier          string             `json:"carrier"`
+	TrackingNumber   string             `json:"tracking_number"`
+	ReturnAddress    *Address           `json:"return_address,omitempty"`
+	Request          *ReturnRequest     `json:"request,omitempty"`
+}
+
+func (z Zinc) CreateReturn(req ReturnRequest) (*ReturnResponse, error) {
+	return z.CreateReturnContext(context.Background(), req)
+}
+
+func (z Zinc) CreateReturnContext(ctx context.Context, req ReturnRequest) (*ReturnResponse, error) {
+	requestPath, err := z.endpoint(ctx, nil, "returns")
+	if err != nil {
+		return nil, err
+	}
+	body := new(bytes.Buffer)
+	if err := json.NewEncoder(body).Encode(req); err != nil {
+		return nil, WrapError(err)
+	}
+	var resp ReturnResponse
+	if decoded, err := z.sendRequest(ctx, "POST", requestPath, body, z.orderTimeout(), &resp); err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
+	}
+	return &resp, nil
+}
+
+func (z Zinc) GetReturnStatus(requestId string) (*ReturnResponse, error) {
+	return z.GetReturnStatusContext(context.Background(), requestId)
+}
+
+func (z Zinc) GetReturnStatusContext(ctx context.Context, requestId string) (*ReturnResponse, error) {
+	requestPath, err := z.endpoint(ctx, nil, "returns", requestId)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ReturnResponse
+	if decoded, err := z.sendRequest(ctx, "GET", requestPath, nil, z.orderTimeout(), &resp); err != nil {
+		if decoded {
+			return &resp, err
+		}
+		return nil, asZincError(err)
+	}
+	return &resp, nil
+}
+
+func (r *ReturnResponse) IsProcessing() bool {
+	return r.Type == "error" && r.Code == CodeRequestProcessing
+}
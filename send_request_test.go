@@ -0,0 +1,72 @@
+package golangsdk_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestSendRequestReportsFailedResponses(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		resp     interface{}
+		wantCode string
+	}{
+		{"error response", `{"_type":"error","code":"invalid_request","message":"bad"}`, &golangsdk.OrderResponse{}, golangsdk.CodeInvalidRequest},
+		{"failed status", `{"status":"failed","code":"internal_error"}`, &golangsdk.ProductOffersResponse{}, "internal_error"},
+		{"processing", `{"_type":"error","code":"request_processing"}`, &golangsdk.OrderResponse{}, ""},
+		{"success", `{"_type":"order_response","request_id":"abc"}`, &golangsdk.OrderResponse{}, ""},
+		{"untyped target", `{"_type":"error","code":"invalid_request"}`, &map[string]interface{}{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+
+			z, _ := golangsdk.NewZinc("user", "")
+			err := z.SendRequest("GET", srv.URL, nil, 0, tt.resp)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("SendRequest: %v", err)
+				}
+				return
+			}
+			var zerr golangsdk.ZincError
+			if !errors.As(err, &zerr) || zerr.Code != tt.wantCode {
+				t.Fatalf("SendRequest error = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestTypedMethodsReturnFailedResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"_type":"error","code":"aborted_request","request_id":"abc","message":"aborted"}`)
+	}))
+	defer srv.Close()
+
+	z, _ := golangsdk.NewZinc("user", "")
+	z.ZincBaseURL = srv.URL + "/v1"
+
+	resp, err := z.GetOrderStatus("abc")
+	if err == nil || resp == nil || resp.Code != golangsdk.CodeAbortedRequest {
+		t.Errorf("GetOrderStatus = %+v, %v; want the decoded response and an error", resp, err)
+	}
+
+	abort, err := z.AbortOrder("abc")
+	if err != nil {
+		t.Fatalf("AbortOrder: %v", err)
+	}
+	if got := abort.AbortResult(); got != golangsdk.AbortAccepted {
+		t.Errorf("AbortResult = %v, want %v", got, golangsdk.AbortAccepted)
+	}
+}
@@ -0,0 +1,74 @@
+package golangsdk
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+type slogSpan struct {
+	ctx       context.Context
+	logger    *slog.Logger
+	method    string
+	path      string
+	retailer  string
+	start     time.Time
+	status    int
+	requestId string
+	err       error
+}
+
+func newSlogSpan(ctx context.Context, logger *slog.Logger, method, path, retailer string) *slogSpan {
+	s := &slogSpan{ctx: ctx, logger: logger, method: method, path: path, retailer: retailer, start: time.Now()}
+	logger.LogAttrs(ctx, slog.LevelDebug, "zinc request started", s.attrs()...)
+	return s
+}
+
+func (s *slogSpan) attrs() []slog.Attr {
+	attrs := []slog.Attr{
+		slog.String("method", s.method),
+		slog.String("path", s.path),
+	}
+	if s.retailer != "" {
+		attrs = append(attrs, slog.String("retailer", s.retailer))
+	}
+	return attrs
+}
+
+func (s *slogSpan) SetAttribute(key string, value interface{}) {
+	switch key {
+	case "http.status_code":
+		s.status, _ = value.(int)
+	case "zinc.request_id":
+		s.requestId, _ = value.(string)
+	}
+}
+
+func (s *slogSpan) RecordError(err error) {
+	s.err = err
+}
+
+func (s *slogSpan) End() {
+	attrs := append(s.attrs(), slog.Duration("duration", time.Since(s.start)))
+	if s.status != 0 {
+		attrs = append(attrs, slog.Int("status", s.status))
+	}
+	if s.requestId != "" {
+		attrs = append(attrs, slog.String("request_id", s.requestId))
+	}
+	if s.err != nil {
+		attrs = append(attrs, slog.String("error", s.err.Error()))
+		s.logger.LogAttrs(s.ctx, slog.LevelError, "zinc request failed", attrs...)
+		return
+	}
+	s.logger.LogAttrs(s.ctx, slog.LevelInfo, "zinc request completed", attrs...)
+}
+
+type slogPrintfLogger struct {
+	logger *slog.Logger
+}
+
+func (l slogPrintfLogger) Printf(format string, v ...interface{}) {
+	l.logger.Warn(fmt.Sprintf(format, v...))
+}
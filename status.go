@@ -0,0 +1,72 @@
+package golangsdk
+
+type OrderStatus string
+
+const (
+	OrderStatusUnknown          OrderStatus = "unknown"
+	OrderStatusProcessing       OrderStatus = "processing"
+	OrderStatusPlaced           OrderStatus = "placed"
+	OrderStatusPartiallyShipped OrderStatus = "partially_shipped"
+	OrderStatusShipped          OrderStatus = "shipped"
+	OrderStatusFailed           OrderStatus = "failed"
+	OrderStatusCancelled        OrderStatus = "cancelled"
+)
+
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusUnknown:          {OrderStatusProcessing, OrderStatusPlaced, OrderStatusPartiallyShipped, OrderStatusShipped, OrderStatusFailed, OrderStatusCancelled},
+	OrderStatusProcessing:       {OrderStatusPlaced, OrderStatusPartiallyShipped, OrderStatusShipped, OrderStatusFailed, OrderStatusCancelled},
+	OrderStatusPlaced:           {OrderStatusPartiallyShipped, OrderStatusShipped, OrderStatusCancelled},
+	OrderStatusPartiallyShipped: {OrderStatusShipped},
+}
+
+var orderStatusNames = map[OrderStatus]string{
+	OrderStatusUnknown:          "Unknown",
+	OrderStatusProcessing:       "Processing",
+	OrderStatusPlaced:           "Placed",
+	OrderStatusPartiallyShipped: "Partially shipped",
+	OrderStatusShipped:          "Shipped",
+	OrderStatusFailed:           "Failed",
+	OrderStatusCancelled:        "Cancelled",
+}
+
+func (s OrderStatus) String() string {
+	if name, ok := orderStatusNames[s]; ok {
+		return name
+	}
+	return string(s)
+}
+
+func (o *OrderResponse) Status() OrderStatus {
+	switch {
+	case o.IsProcessing():
+		return OrderStatusProcessing
+	case o.Type == "error" && o.Code == CodeAbortedRequest:
+		return OrderStatusCancelled
+	case o.Type == "error":
+		return OrderStatusFailed
+	case o.AllTracked():
+		return OrderStatusShipped
+	case len(o.Tracking) > 0:
+		return OrderStatusPartiallyShipped
+	case len(o.MerchantOrderIds) > 0 || o.Type == "order_response":
+		return OrderStatusPlaced
+	default:
+		return OrderStatusUnknown
+	}
+}
+
+func (s OrderStatus) IsTerminal() bool {
+	return len(orderStatusTransitions[s]) == 0
+}
+
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	if s == next {
+		return true
+	}
+	for _, allowed := range orderStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package golangsdk
+
+import (
+	"context"
+	"net/url"
+)
+
+// Tracer lets callers plug in distributed tracing (e.g. an OpenTelemetry
+// adapter) without this package depending on a tracing library.
+type Tracer interface {
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+type Span interface {
+	SetAttribute(key string, value interface{})
+	RecordError(err error)
+	End()
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(key string, value interface{}) {}
+func (noopSpan) RecordError(err error)                      {}
+func (noopSpan) End()                                       {}
+
+type multiSpan []Span
+
+func (m multiSpan) SetAttribute(key string, value interface{}) {
+	for _, s := range m {
+		s.SetAttribute(key, value)
+	}
+}
+
+func (m multiSpan) RecordError(err error) {
+	for _, s := range m {
+		s.RecordError(err)
+	}
+}
+
+func (m multiSpan) End() {
+	for _, s := range m {
+		s.End()
+	}
+}
+
+type retailerContextKey struct{}
+
+// WithRetailer tags requests made with ctx with retailer in spans and slog
+// records. Requests that name the retailer in their query, and SendOrder,
+// are tagged without it; use it for calls such as Reship, CreateReturn or
+// CreateCase whose requests do not carry the retailer.
+func WithRetailer(ctx context.Context, retailer Retailer) context.Context {
+	return context.WithValue(ctx, retailerContextKey{}, retailer)
+}
+
+func (z Zinc) observesRequests() bool {
+	return z.Tracer != nil || z.Slog != nil
+}
+
+func (z Zinc) startSpan(ctx context.Context, method, requestPath string) (context.Context, Span) {
+	if !z.observesRequests() {
+		return ctx, noopSpan{}
+	}
+	path := requestPath
+	var retailer string
+	if u, err := url.Parse(requestPath); err == nil {
+		path = u.Path
+		retailer = u.Query().Get("retailer")
+	}
+	if r, ok := ctx.Value(retailerContextKey{}).(Retailer); ok && retailer == "" {
+		retailer = string(r)
+	}
+
+	var spans multiSpan
+	if z.Tracer != nil {
+		var span Span
+		ctx, span = z.Tracer.Start(ctx, "zinc "+method+" "+path)
+		span.SetAttribute("http.method", method)
+		span.SetAttribute("url.path", path)
+		if retailer != "" {
+			span.SetAttribute("zinc.retailer", retailer)
+		}
+		spans = append(spans, span)
+	}
+	if z.Slog != nil {
+		spans = append(spans, newSlogSpan(ctx, z.Slog, method, path, retailer))
+	}
+	return ctx, spans
+}
+
+func peekRequestId(body []byte) string {
+	var v struct {
+		RequestId string `json:"request_id"`
+	}
+	decodeRespBody(body, &v)
+	return v.RequestId
+}
@@ -0,0 +1,61 @@
+package golangsdk_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestSlogRecordsRetailerForBodyRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"request_id":"abc"}`)
+	}))
+	defer srv.Close()
+
+	var logs bytes.Buffer
+	z, _ := golangsdk.NewZinc("user", "")
+	z.ZincBaseURL = srv.URL + "/v1"
+	z.SkipValidation = true
+	z.Slog = slog.New(slog.NewJSONHandler(&logs, nil))
+
+	if _, err := z.SendOrder(golangsdk.OrderRequest{
+		Retailer: golangsdk.AmazonUK,
+		Products: []golangsdk.Product{{ProductId: "B07XJ8C8F5", Quantity: 1}},
+	}); err != nil {
+		t.Fatalf("SendOrder: %v", err)
+	}
+	ctx := golangsdk.WithRetailer(context.Background(), golangsdk.AmazonUK)
+	if _, err := z.CreateReturnContext(ctx, golangsdk.ReturnRequest{MerchantOrderId: "112-1234567"}); err != nil {
+		t.Fatalf("CreateReturn: %v", err)
+	}
+	if _, err := z.CreateCaseContext(ctx, "abc", golangsdk.CaseRequest{Message: "late"}); err != nil {
+		t.Fatalf("CreateCase: %v", err)
+	}
+
+	var completed int
+	dec := json.NewDecoder(&logs)
+	for dec.More() {
+		var record map[string]interface{}
+		if err := dec.Decode(&record); err != nil {
+			t.Fatal(err)
+		}
+		if record["msg"] != "zinc request completed" {
+			continue
+		}
+		completed++
+		if record["retailer"] != string(golangsdk.AmazonUK) {
+			t.Errorf("%v %v: retailer = %v, want %v", record["method"], record["path"], record["retailer"], golangsdk.AmazonUK)
+		}
+	}
+	if completed != 3 {
+		t.Errorf("logged %d completed requests, want 3", completed)
+	}
+}
@@ -0,0 +1,128 @@
+package golangsdk
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type Carrier string
+
+const (
+	CarrierUPS             Carrier = "ups"
+	CarrierUSPS            Carrier = "usps"
+	CarrierFedEx           Carrier = "fedex"
+	CarrierDHL             Carrier = "dhl"
+	CarrierAmazonLogistics Carrier = "amazon_logistics"
+)
+
+var carrierAliases = map[string]Carrier{
+	"ups":                       CarrierUPS,
+	"unitedparcelservice":       CarrierUPS,
+	"usps":                      CarrierUSPS,
+	"unitedstatespostalservice": CarrierUSPS,
+	"fedex":                     CarrierFedEx,
+	"federalexpress":            CarrierFedEx,
+	"dhl":                       CarrierDHL,
+	"dhlexpress":                CarrierDHL,
+	"amzl":                      CarrierAmazonLogistics,
+	"amzlus":                    CarrierAmazonLogistics,
+	"amazon":                    CarrierAmazonLogistics,
+	"amazonlogistics":           CarrierAmazonLogistics,
+}
+
+var carrierTrackingURLs = map[Carrier]string{
+	CarrierUPS:             "https://www.ups.com/track?tracknum=%v",
+	CarrierUSPS:            "https://tools.usps.com/go/TrackConfirmAction?tLabels=%v",
+	CarrierFedEx:           "https://www.fedex.com/fedextrack/?trknbr=%v",
+	CarrierDHL:             "https://www.dhl.com/en/express/tracking.html?AWB=%v",
+	CarrierAmazonLogistics: "https://track.amazon.com/tracking/%v",
+}
+
+func ParseCarrier(carrier string) Carrier {
+	key := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, strings.ToLower(carrier))
+	if c, ok := carrierAliases[key]; ok {
+		return c
+	}
+	return Carrier(strings.ToLower(strings.TrimSpace(carrier)))
+}
+
+func (c Carrier) TrackingURL(trackingNumber string) (string, bool) {
+	format, ok := carrierTrackingURLs[c]
+	if !ok || trackingNumber == "" {
+		return "", false
+	}
+	return fmt.Sprintf(format, url.QueryEscape(trackingNumber)), true
+}
+
+func (t Tracking) NormalizedCarrier() Carrier {
+	return ParseCarrier(t.Carrier)
+}
+
+func (t Tracking) CanonicalTrackingURL() string {
+	if t.TrackingURL != "" {
+		return t.TrackingURL
+	}
+	u, _ := t.NormalizedCarrier().TrackingURL(t.TrackingNumber)
+	return u
+}
+
+func (o *OrderResponse) TrackingFor(merchantOrderId string) []Tracking {
+	var tracking []Tracking
+	for _, t := range o.Tracking {
+		if t.MerchantOrderId == merchantOrderId {
+			tracking = append(tracking, t)
+		}
+	}
+	return tracking
+}
+
+func (o *OrderResponse) UntrackedMerchantOrderIds() []string {
+	tracked := make(map[string]bool, len(o.Tracking))
+	for _, t := range o.Tracking {
+		tracked[t.MerchantOrderId] = true
+	}
+	var untracked []string
+	for _, m := range o.MerchantOrderIds {
+		if !tracked[m.MerchantOrderId] {
+			untracked = append(untracked, m.MerchantOrderId)
+		}
+	}
+	return untracked
+}
+
+func (o *OrderResponse) AllTracked() bool {
+	return len(o.MerchantOrderIds) > 0 && len(o.UntrackedMerchantOrderIds()) == 0
+}
+
+type trackingKey struct {
+	merchantOrderId string
+	trackingNumber  string
+}
+
+func NewTracking(previous, current *OrderResponse) []Tracking {
+	if current == nil {
+		return nil
+	}
+	seen := make(map[trackingKey]bool)
+	if previous != nil {
+		for _, t := range previous.Tracking {
+			seen[trackingKey{t.MerchantOrderId, t.TrackingNumber}] = true
+		}
+	}
+	var added []Tracking
+	for _, t := range current.Tracking {
+		key := trackingKey{t.MerchantOrderId, t.TrackingNumber}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		added = append(added, t)
+	}
+	return added
+}
@@ -0,0 +1,246 @@
+package golangsdk
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var usZipCodeRegexp = regexp.MustCompile(`^\d{5}(-\d{4})?$`)
+
+var usStateCodes = map[string]bool{
+	"AL": true, "AK": true, "AZ": true, "AR": true, "CA": true, "CO": true, "CT": true,
+	"DE": true, "DC": true, "FL": true, "GA": true, "HI": true, "ID": true, "IL": true,
+	"IN": true, "IA": true, "KS": true, "KY": true, "LA": true, "ME": true, "MD": true,
+	"MA": true, "MI": true, "MN": true, "MS": true, "MO": true, "MT": true, "NE": true,
+	"NV": true, "NH": true, "NJ": true, "NM": true, "NY": true, "NC": true, "ND": true,
+	"OH": true, "OK": true, "OR": true, "PA": true, "RI": true, "SC": true, "SD": true,
+	"TN": true, "TX": true, "UT": true, "VT": true, "VA": true, "WA": true, "WV": true,
+	"WI": true, "WY": true, "AS": true, "GU": true, "MP": true, "PR": true, "VI": true,
+	"AA": true, "AE": true, "AP": true,
+}
+
+type ValidationError struct {
+	Errors []string
+}
+
+func (v ValidationError) Error() string {
+	return fmt.Sprintf("Validation failed: %v", strings.Join(v.Errors, "; "))
+}
+
+func (v *ValidationError) add(format string, args ...interface{}) {
+	v.Errors = append(v.Errors, fmt.Sprintf(format, args...))
+}
+
+func (v ValidationError) errOrNil() error {
+	if len(v.Errors) == 0 {
+		return nil
+	}
+	return v
+}
+
+func (o OrderRequest) Validate() error {
+	var verr ValidationError
+	_, retailerErr := GetRetailer(string(o.Retailer))
+	if retailerErr != nil {
+		verr.add("invalid retailer %q", o.Retailer)
+	}
+	if len(o.Products) == 0 {
+		verr.add("products must not be empty")
+	}
+	for i, p := range o.Products {
+		if p.ProductId == "" {
+			verr.add("products[%d].product_id is required", i)
+		} else if retailerErr == nil && !ValidProductId(o.Retailer, canonicalProductId(o.Retailer, p.ProductId)) {
+			verr.add("products[%d].product_id %q is not a valid %v product id", i, p.ProductId, o.Retailer)
+		}
+		if p.Quantity <= 0 {
+			verr.add("products[%d].quantity must be positive, got %d", i, p.Quantity)
+		}
+	}
+	if o.ShippingMethod != "" && !o.ShippingMethod.Valid() {
+		verr.add("invalid shipping_method %q", o.ShippingMethod)
+	}
+	if o.Shipping != nil && o.Shipping.OrderBy != "" && !o.Shipping.OrderBy.Valid() {
+		verr.add("invalid shipping.order_by %q", o.Shipping.OrderBy)
+	}
+	for i, code := range o.PromoCodes {
+		if strings.TrimSpace(code) == "" {
+			verr.add("promo_codes[%d] must not be empty", i)
+		}
+	}
+	if o.GiftOptions != nil && !o.IsGift {
+		verr.add("gift_options requires is_gift")
+	}
+	if o.AddaxOptions != nil && !o.Addax {
+		verr.add("addax_options requires addax")
+	}
+	if o.Bundled {
+		o.validateBundle(&verr)
+	}
+	if o.RetailerCredentials != nil {
+		o.RetailerCredentials.validate(&verr, "retailer_credentials.", o.Retailer)
+	}
+	if o.ShippingAddress == nil {
+		verr.add("shipping_address is required")
+	} else {
+		o.ShippingAddress.validate(&verr, "shipping_address.")
+	}
+	if o.BillingAddress != nil {
+		o.BillingAddress.validate(&verr, "billing_address.")
+	}
+	if o.PaymentMethod != nil {
+		o.PaymentMethod.validate(&verr, "payment_method.", time.Now())
+	}
+	return verr.errOrNil()
+}
+
+func (o OrderRequest) validateBundle(verr *ValidationError) {
+	if !o.Retailer.isAmazon() {
+		verr.add("bundled is only supported for amazon retailers, got %q", o.Retailer)
+	}
+	seen := make(map[string]int, len(o.Products))
+	for i, p := range o.Products {
+		if p.ProductId == "" {
+			continue
+		}
+		if first, ok := seen[p.ProductId]; ok {
+			verr.add("products[%d].product_id %q duplicates products[%d] in bundled order", i, p.ProductId, first)
+			continue
+		}
+		seen[p.ProductId] = i
+	}
+}
+
+func (p PriceComponents) Validate() error {
+	var verr ValidationError
+	if sum := p.Shipping + p.Subtotal + p.Tax; sum != p.Total {
+		verr.add("total %d does not match shipping %d + subtotal %d + tax %d = %d", p.Total, p.Shipping, p.Subtotal, p.Tax, sum)
+	}
+	return verr.errOrNil()
+}
+
+func (a Address) Normalized() Address {
+	a.FirstName = strings.TrimSpace(a.FirstName)
+	a.LastName = strings.TrimSpace(a.LastName)
+	a.AddressLine1 = strings.TrimSpace(a.AddressLine1)
+	a.AddressLine2 = strings.TrimSpace(a.AddressLine2)
+	a.ZipCode = strings.TrimSpace(a.ZipCode)
+	a.City = strings.TrimSpace(a.City)
+	a.State = strings.ToUpper(strings.TrimSpace(a.State))
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+	a.PhoneNumber = strings.TrimSpace(a.PhoneNumber)
+	return a
+}
+
+func (a Address) Validate() error {
+	var verr ValidationError
+	a.validate(&verr, "")
+	return verr.errOrNil()
+}
+
+func (a Address) validate(verr *ValidationError, prefix string) {
+	a = a.Normalized()
+	required := []struct {
+		name  string
+		value string
+	}{
+		{"first_name", a.FirstName},
+		{"last_name", a.LastName},
+		{"address_line1", a.AddressLine1},
+		{"zip_code", a.ZipCode},
+		{"city", a.City},
+		{"country", a.Country},
+	}
+	for _, field := range required {
+		if field.value == "" {
+			verr.add("%v%v is required", prefix, field.name)
+		}
+	}
+	if a.Country != "US" {
+		return
+	}
+	if !usStateCodes[a.State] {
+		verr.add("%vstate %q is not a valid US state code", prefix, a.State)
+	}
+	if a.ZipCode != "" && !usZipCodeRegexp.MatchString(a.ZipCode) {
+		verr.add("%vzip_code %q is not a valid US zip code", prefix, a.ZipCode)
+	}
+}
+
+func (p PaymentMethod) Validate() error {
+	var verr ValidationError
+	p.validate(&verr, "", time.Now())
+	return verr.errOrNil()
+}
+
+func (p PaymentMethod) validate(verr *ValidationError, prefix string, now time.Time) {
+	if p.UseGift && p.Number == "" {
+		return
+	}
+	number := strings.NewReplacer(" ", "", "-", "").Replace(p.Number)
+	if !luhnValid(number) {
+		verr.add("%vnumber is not a valid card number", prefix)
+	}
+	if n := len(p.SecurityCode); n < 3 || n > 4 || strings.Trim(p.SecurityCode, "0123456789") != "" {
+		verr.add("%vsecurity_code must be 3 or 4 digits", prefix)
+	}
+	if p.ExpirationMonth < 1 || p.ExpirationMonth > 12 {
+		verr.add("%vexpiration_month must be between 1 and 12, got %d", prefix, p.ExpirationMonth)
+		return
+	}
+	year := p.ExpirationYear
+	if year < 100 {
+		year += 2000
+	}
+	if year < now.Year() || (year == now.Year() && p.ExpirationMonth < int(now.Month())) {
+		verr.add("%vcard expired %02d/%d", prefix, p.ExpirationMonth, year)
+	}
+}
+
+func luhnValid(number string) bool {
+	if len(number) < 12 || len(number) > 19 {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func (c RetailerCredentials) ValidateFor(retailer Retailer) error {
+	var verr ValidationError
+	c.validate(&verr, "", retailer)
+	return verr.errOrNil()
+}
+
+func (c RetailerCredentials) validate(verr *ValidationError, prefix string, retailer Retailer) {
+	switch retailer {
+	case Aliexpress:
+		if c.Email == "" && c.PhoneNumber == "" && c.Username == "" {
+			verr.add("%vemail, phone_number or username is required for %v", prefix, retailer)
+		}
+	default:
+		if c.Email == "" {
+			verr.add("%vemail is required for %v", prefix, retailer)
+		}
+	}
+	if c.Password == "" && c.SessionCookies == "" {
+		verr.add("%vpassword or session_cookies is required", prefix)
+	}
+}
@@ -0,0 +1,44 @@
+package golangsdk_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestValidateAcceptsLowercaseASIN(t *testing.T) {
+	order := golangsdk.OrderRequest{
+		Retailer: golangsdk.Amazon,
+		Products: []golangsdk.Product{{ProductId: "b0000abcde", Quantity: 1}},
+		ShippingAddress: &golangsdk.Address{
+			FirstName:    "Tim",
+			LastName:     "Beaver",
+			AddressLine1: "77 Massachusetts Avenue",
+			ZipCode:      "02139",
+			City:         "Cambridge",
+			State:        "MA",
+			Country:      "US",
+			PhoneNumber:  "5551230101",
+		},
+	}
+	if err := order.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	z, _ := golangsdk.NewZinc("user", "")
+	_, body, err := z.BuildOrderRequest(order)
+	if err != nil {
+		t.Fatalf("BuildOrderRequest: %v", err)
+	}
+	var sent golangsdk.OrderRequest
+	if err := json.Unmarshal(body, &sent); err != nil {
+		t.Fatal(err)
+	}
+	if got := sent.Products[0].ProductId; got != "B0000ABCDE" {
+		t.Errorf("sent product_id = %q, want %q", got, "B0000ABCDE")
+	}
+	if order.Products[0].ProductId != "b0000abcde" {
+		t.Errorf("BuildOrderRequest modified the caller's order")
+	}
+}
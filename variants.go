@@ -0,0 +1,29 @@
+package golangsdk
+
+import "strings"
+
+func FindVariant(variants []Variant, dims map[string]string) (*Variant, bool) {
+	for i := range variants {
+		if variantMatches(variants[i].VariantSpecifics, dims) {
+			return &variants[i], true
+		}
+	}
+	return nil, false
+}
+
+func variantMatches(specifics []VariantSpecific, dims map[string]string) bool {
+	for dimension, value := range dims {
+		found := false
+		for _, s := range specifics {
+			if strings.EqualFold(strings.TrimSpace(s.Dimension), strings.TrimSpace(dimension)) &&
+				strings.EqualFold(strings.TrimSpace(s.Value), strings.TrimSpace(value)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
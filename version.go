@@ -0,0 +1,45 @@
+package golangsdk
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+const defaultOffersVersion = "2"
+
+var (
+	apiVersionRegexp = regexp.MustCompile(`/v\d+$`)
+	digitsRegexp     = regexp.MustCompile(`^\d+$`)
+)
+
+type apiVersionContextKey struct{}
+
+func WithAPIVersion(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, apiVersionContextKey{}, version)
+}
+
+// apiVersion returns the path segment for the requested API version.
+// Both "2" and "v2" select /v2, matching what OffersVersion accepts.
+func (z Zinc) apiVersion(ctx context.Context) string {
+	version := z.APIVersion
+	if v, ok := ctx.Value(apiVersionContextKey{}).(string); ok && v != "" {
+		version = v
+	}
+	version = strings.Trim(strings.TrimSpace(version), "/")
+	if digitsRegexp.MatchString(version) {
+		return "v" + version
+	}
+	return version
+}
+
+func (z Zinc) offersVersion() string {
+	version := strings.Trim(strings.TrimSpace(z.OffersVersion), "/")
+	if digits := strings.TrimLeft(version, "vV"); digitsRegexp.MatchString(digits) {
+		return digits
+	}
+	if version != "" {
+		return version
+	}
+	return defaultOffersVersion
+}
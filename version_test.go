@@ -0,0 +1,48 @@
+package golangsdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestAPIVersionForms(t *testing.T) {
+	var gotPath, gotVersion string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotVersion = r.URL.Query().Get("version")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status":"completed"}`)
+	}))
+	defer srv.Close()
+
+	for _, version := range []string{"2", "v2", "/v2/", " 2 "} {
+		z, _ := golangsdk.NewZinc("user", "")
+		z.ZincBaseURL = srv.URL + "/v1"
+		z.APIVersion = version
+		z.OffersVersion = version
+		if _, err := z.GetProductOffers("B07XJ8C8F5", golangsdk.Amazon, golangsdk.ProductOptions{}); err != nil {
+			t.Fatalf("version %q: %v", version, err)
+		}
+		if gotPath != "/v2/products/B07XJ8C8F5/offers" {
+			t.Errorf("APIVersion %q: path = %q, want /v2/products/B07XJ8C8F5/offers", version, gotPath)
+		}
+		if gotVersion != "2" {
+			t.Errorf("OffersVersion %q: version = %q, want 2", version, gotVersion)
+		}
+	}
+
+	z, _ := golangsdk.NewZinc("user", "")
+	z.ZincBaseURL = srv.URL + "/v1"
+	ctx := golangsdk.WithAPIVersion(context.Background(), "3")
+	if _, err := z.GetProductDetailsContext(ctx, "B07XJ8C8F5", golangsdk.Amazon, golangsdk.ProductOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/v3/products/B07XJ8C8F5" {
+		t.Errorf("WithAPIVersion(3): path = %q, want /v3/products/B07XJ8C8F5", gotPath)
+	}
+}
@@ -0,0 +1,245 @@
+package golangsdk
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type WebhookEventType string
+
+const (
+	WebhookRequestSucceeded WebhookEventType = "request_succeeded"
+	WebhookRequestFailed    WebhookEventType = "request_failed"
+	WebhookTrackingObtained WebhookEventType = "tracking_obtained"
+	WebhookStatusUpdated    WebhookEventType = "status_updated"
+)
+
+const (
+	WebhookSignatureHeader = "X-Zinc-Signature"
+
+	webhookEventParam     = "event"
+	maxWebhookBodyBytes   = 10 << 20
+	webhookForwardTimeout = 30 * time.Second
+)
+
+type WebhookEvent struct {
+	Type     WebhookEventType
+	Order    OrderResponse
+	Tracking []Tracking
+	Body     []byte
+	// Signature is the X-Zinc-Signature header the event arrived with.
+	Signature string
+
+	ctx context.Context
+}
+
+func (e WebhookEvent) context() context.Context {
+	if e.ctx == nil {
+		return context.Background()
+	}
+	return e.ctx
+}
+
+func NewWebhooks(baseURL string) (*Webhooks, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, SimpleError(fmt.Sprintf("Invalid webhook URL %q: %v", baseURL, err))
+	}
+	withEvent := func(event WebhookEventType) string {
+		eu := *u
+		q := eu.Query()
+		q.Set(webhookEventParam, string(event))
+		eu.RawQuery = q.Encode()
+		return eu.String()
+	}
+	return &Webhooks{
+		RequestSucceeded: withEvent(WebhookRequestSucceeded),
+		RequestFailed:    withEvent(WebhookRequestFailed),
+		TrackingObtained: withEvent(WebhookTrackingObtained),
+		StatusUpdated:    withEvent(WebhookStatusUpdated),
+	}, nil
+}
+
+func ParseWebhook(r *http.Request) (WebhookEvent, error) {
+	return parseWebhookRequest(r, "")
+}
+
+func (z Zinc) ParseWebhook(r *http.Request) (WebhookEvent, error) {
+	return parseWebhookRequest(r, z.WebhookSecret)
+}
+
+func parseWebhookRequest(r *http.Request, secret string) (WebhookEvent, error) {
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxWebhookBodyBytes))
+	if err != nil {
+		return WebhookEvent{}, WrapError(err)
+	}
+	if secret != "" {
+		if err := VerifyWebhookSignature(body, r.Header.Get(WebhookSignatureHeader), secret); err != nil {
+			return WebhookEvent{}, err
+		}
+	}
+	event, err := parseWebhookBody(r.URL.Query().Get(webhookEventParam), body)
+	event.Signature = r.Header.Get(WebhookSignatureHeader)
+	event.ctx = r.Context()
+	return event, err
+}
+
+func VerifyWebhookSignature(body []byte, signatureHeader, secret string) error {
+	signature := strings.TrimPrefix(strings.TrimSpace(signatureHeader), "sha256=")
+	if signature == "" {
+		return SimpleError("Missing webhook signature")
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return SimpleError("Malformed webhook signature")
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return SimpleError("Invalid webhook signature")
+	}
+	return nil
+}
+
+func parseWebhookBody(eventParam string, body []byte) (WebhookEvent, error) {
+	event := WebhookEvent{Body: body}
+	if err := json.Unmarshal(body, &event.Order); err != nil {
+		return event, WrapError(err)
+	}
+	event.Tracking = event.Order.Tracking
+	switch WebhookEventType(eventParam) {
+	case WebhookRequestSucceeded, WebhookRequestFailed, WebhookTrackingObtained, WebhookStatusUpdated:
+		event.Type = WebhookEventType(eventParam)
+	case "":
+		event.Type = inferWebhookEventType(&event.Order)
+	default:
+		return event, SimpleError(fmt.Sprintf("Unknown webhook event %q", eventParam))
+	}
+	return event, nil
+}
+
+func inferWebhookEventType(o *OrderResponse) WebhookEventType {
+	switch {
+	case o.Type == "error" && !o.IsProcessing():
+		return WebhookRequestFailed
+	case len(o.Tracking) > 0:
+		return WebhookTrackingObtained
+	case o.IsProcessing():
+		return WebhookStatusUpdated
+	default:
+		return WebhookRequestSucceeded
+	}
+}
+
+type WebhookHandler struct {
+	Secret             string
+	OnOrderSucceeded   func(WebhookEvent) error
+	OnOrderFailed      func(WebhookEvent) error
+	OnTrackingObtained func(WebhookEvent) error
+	OnStatusUpdated    func(WebhookEvent) error
+}
+
+func (z Zinc) WebhookHandler(h WebhookHandler) *WebhookHandler {
+	if h.Secret == "" {
+		h.Secret = z.WebhookSecret
+	}
+	return &h
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	event, err := parseWebhookRequest(r, h.Secret)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var callback func(WebhookEvent) error
+	switch event.Type {
+	case WebhookRequestSucceeded:
+		callback = h.OnOrderSucceeded
+	case WebhookRequestFailed:
+		callback = h.OnOrderFailed
+	case WebhookTrackingObtained:
+		callback = h.OnTrackingObtained
+	case WebhookStatusUpdated:
+		callback = h.OnStatusUpdated
+	}
+	if callback != nil {
+		if err := callback(event); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// FanoutWebhook combines callbacks so one event type can feed several
+// consumers. The API accepts a single URL per event, so fan-out happens
+// on the receiving side. Every callback runs; their errors are joined.
+func FanoutWebhook(callbacks ...func(WebhookEvent) error) func(WebhookEvent) error {
+	return func(event WebhookEvent) error {
+		var errs []error
+		for _, callback := range callbacks {
+			if err := callback(event); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// ForwardWebhook re-posts the raw event body to each URL along with its
+// original signature, so a downstream WebhookHandler sharing the secret
+// accepts it. Forwards are bound to the incoming request's context; a nil
+// client gets one that gives up after 30 seconds.
+func ForwardWebhook(client *http.Client, urls ...string) func(WebhookEvent) error {
+	if client == nil {
+		client = &http.Client{
+			Transport: defaultHTTPClient.Transport,
+			Timeout:   webhookForwardTimeout,
+		}
+	}
+	return func(event WebhookEvent) error {
+		var errs []error
+		for _, target := range urls {
+			req, err := http.NewRequestWithContext(event.context(), http.MethodPost, target, bytes.NewReader(event.Body))
+			if err != nil {
+				errs = append(errs, WrapError(err))
+				continue
+			}
+			req.Header.Set("Content-Type", "application/json")
+			if event.Signature != "" {
+				req.Header.Set(WebhookSignatureHeader, event.Signature)
+			}
+			q := req.URL.Query()
+			q.Set(webhookEventParam, string(event.Type))
+			req.URL.RawQuery = q.Encode()
+			resp, err := client.Do(req)
+			if err != nil {
+				errs = append(errs, WrapError(err))
+				continue
+			}
+			resp.Body.Close()
+			if resp.StatusCode < 200 || resp.StatusCode > 299 {
+				errs = append(errs, SimpleError(fmt.Sprintf("Webhook forward to %v returned HTTP status %d", target, resp.StatusCode)))
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
@@ -0,0 +1,56 @@
+package golangsdk_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zincio/golangsdk"
+)
+
+func TestForwardWebhookKeepsSignature(t *testing.T) {
+	const secret = "whsec"
+	received := make(chan golangsdk.WebhookEvent, 1)
+	downstream := httptest.NewServer(&golangsdk.WebhookHandler{
+		Secret: secret,
+		OnTrackingObtained: func(event golangsdk.WebhookEvent) error {
+			received <- event
+			return nil
+		},
+	})
+	defer downstream.Close()
+	upstream := httptest.NewServer(&golangsdk.WebhookHandler{
+		Secret:             secret,
+		OnTrackingObtained: golangsdk.ForwardWebhook(nil, downstream.URL),
+	})
+	defer upstream.Close()
+
+	body := `{"_type":"order_response","request_id":"abc","tracking":[{"tracking_number":"1Z999"}]}`
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	req, err := http.NewRequest(http.MethodPost, upstream.URL+"?event=tracking_obtained", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set(golangsdk.WebhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("upstream status = %d, want 200", resp.StatusCode)
+	}
+	select {
+	case event := <-received:
+		if event.Order.RequestId != "abc" || string(event.Body) != body {
+			t.Errorf("forwarded event = %+v", event)
+		}
+	default:
+		t.Fatal("downstream handler did not receive the forwarded event")
+	}
+}
@@ -0,0 +1,59 @@
+package zinctest
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/zincio/golangsdk"
+)
+
+// Fixtures are captured Zinc API payloads with every field populated, for
+// round-trip and golden-file checks against the SDK's types.
+//
+//go:embed testdata/*.json
+var fixtures embed.FS
+
+func Fixture(name string) []byte {
+	data, err := fixtures.ReadFile("testdata/" + name)
+	if err != nil {
+		panic(fmt.Sprintf("zinctest: unknown fixture %q", name))
+	}
+	return data
+}
+
+func SampleProductOffers() golangsdk.ProductOffersResponse {
+	var resp golangsdk.ProductOffersResponse
+	mustDecodeFixture("product_offers.json", &resp)
+	return resp
+}
+
+func SampleProductDetails() golangsdk.ProductDetailsResponse {
+	var resp golangsdk.ProductDetailsResponse
+	mustDecodeFixture("product_details.json", &resp)
+	return resp
+}
+
+func SampleOrderResponse() golangsdk.OrderResponse {
+	var resp golangsdk.OrderResponse
+	mustDecodeFixture("order_response.json", &resp)
+	return resp
+}
+
+func SampleOrderError() golangsdk.OrderResponse {
+	var resp golangsdk.OrderResponse
+	mustDecodeFixture("order_error.json", &resp)
+	return resp
+}
+
+func SampleOrderRequest() golangsdk.OrderRequest {
+	var req golangsdk.OrderRequest
+	mustDecodeFixture("order_request.json", &req)
+	return req
+}
+
+func mustDecodeFixture(name string, v interface{}) {
+	if err := json.Unmarshal(Fixture(name), v); err != nil {
+		panic(fmt.Sprintf("zinctest: decoding fixture %q: %v", name, err))
+	}
+}
@@ -0,0 +1,160 @@
+// Package zinctest provides an in-process mock of the Zinc API for tests.
+package zinctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/zincio/golangsdk"
+)
+
+type injectedError struct {
+	statusCode int
+	body       string
+}
+
+type Server struct {
+	*httptest.Server
+
+	mu             sync.Mutex
+	nextRequestId  int
+	orders         map[string]golangsdk.OrderResponse
+	offers         map[string]golangsdk.ProductOffersResponse
+	details        map[string]golangsdk.ProductDetailsResponse
+	errors         map[string]injectedError
+	receivedOrders []golangsdk.OrderRequest
+}
+
+func NewServer() *Server {
+	s := &Server{
+		orders:  make(map[string]golangsdk.OrderResponse),
+		offers:  make(map[string]golangsdk.ProductOffersResponse),
+		details: make(map[string]golangsdk.ProductDetailsResponse),
+		errors:  make(map[string]injectedError),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *Server) Client() *golangsdk.Zinc {
+	z, _ := golangsdk.NewZinc("zinctest", "")
+	z.ZincBaseURL = s.URL + "/v1"
+	return z
+}
+
+func (s *Server) SetOrder(requestId string, resp golangsdk.OrderResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp.RequestId = requestId
+	s.orders[requestId] = resp
+}
+
+func (s *Server) SetProductOffers(productId string, resp golangsdk.ProductOffersResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.offers[productId] = resp
+}
+
+func (s *Server) SetProductDetails(productId string, resp golangsdk.ProductDetailsResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.details[productId] = resp
+}
+
+// InjectError makes every request matching method and path (without query
+// string, e.g. "/v1/orders") respond with statusCode and body.
+func (s *Server) InjectError(method, path string, statusCode int, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors[method+" "+path] = injectedError{statusCode: statusCode, body: body}
+}
+
+func (s *Server) ClearErrors() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors = make(map[string]injectedError)
+}
+
+func (s *Server) ReceivedOrders() []golangsdk.OrderRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]golangsdk.OrderRequest(nil), s.receivedOrders...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if injected, ok := s.errors[r.Method+" "+r.URL.Path]; ok {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(injected.statusCode)
+		fmt.Fprint(w, injected.body)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1"), "/"), "/")
+	switch {
+	case r.Method == http.MethodPost && len(parts) == 1 && parts[0] == "orders":
+		s.createOrder(w, r)
+	case r.Method == http.MethodGet && len(parts) == 2 && parts[0] == "orders":
+		resp, ok := s.orders[parts[1]]
+		if !ok {
+			resp = golangsdk.OrderResponse{RequestId: parts[1], Type: "error", Code: golangsdk.CodeRequestProcessing}
+		}
+		writeJSON(w, http.StatusOK, resp)
+	case r.Method == http.MethodPost && len(parts) == 3 && parts[0] == "orders" && parts[2] == "abort":
+		resp := golangsdk.OrderResponse{RequestId: parts[1], Type: "error", Code: golangsdk.CodeAbortedRequest}
+		s.orders[parts[1]] = resp
+		writeJSON(w, http.StatusOK, resp)
+	case r.Method == http.MethodGet && len(parts) == 3 && parts[0] == "products" && parts[2] == "offers":
+		resp, ok := s.offers[parts[1]]
+		if !ok {
+			notFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, resp)
+	case r.Method == http.MethodGet && len(parts) == 2 && parts[0] == "products":
+		resp, ok := s.details[parts[1]]
+		if !ok {
+			notFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, resp)
+	default:
+		notFound(w)
+	}
+}
+
+func (s *Server) createOrder(w http.ResponseWriter, r *http.Request) {
+	var order golangsdk.OrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&order); err != nil {
+		writeJSON(w, http.StatusOK, golangsdk.OrderResponse{
+			Type:         "error",
+			Code:         golangsdk.CodeInvalidRequest,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	s.receivedOrders = append(s.receivedOrders, order)
+	s.nextRequestId++
+	requestId := fmt.Sprintf("zinctest-%d", s.nextRequestId)
+	writeJSON(w, http.StatusOK, golangsdk.OrderResponse{RequestId: requestId})
+}
+
+func notFound(w http.ResponseWriter) {
+	writeJSON(w, http.StatusNotFound, map[string]string{
+		"_type":   "error",
+		"code":    "not_found",
+		"message": "zinctest: no canned response",
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(v)
+}